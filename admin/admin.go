@@ -0,0 +1,309 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sygmaprotocol/sygma-core/relayer/message"
+	evmMessage "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+)
+
+type RouteBlockStorer interface {
+	StoreBlock(sourceDomainID uint8, destinationDomainID uint8, blockNumber *big.Int) error
+	LatestBlock(sourceDomainID uint8, destinationDomainID uint8) (*big.Int, error)
+}
+
+type RetryEntryFetcher interface {
+	RetryQueue(domainID uint8) ([]*store.RetryEntry, error)
+	DeadLetters(domainID uint8) ([]*store.RetryEntry, error)
+}
+
+type ExecutionFetcher interface {
+	Execution(messageID string) (*store.Execution, error)
+	PendingTransactions(domainID uint8) ([]*store.PendingTransaction, error)
+}
+
+type RouteBlock struct {
+	Source      uint8  `json:"source"`
+	Destination uint8  `json:"destination"`
+	Block       string `json:"block"`
+}
+
+type SetBlockRequest struct {
+	Block string `json:"block"`
+}
+
+type RewindRequest struct {
+	Blocks uint64 `json:"blocks"`
+}
+
+type ReprocessRequest struct {
+	StartBlock string `json:"startBlock"`
+}
+
+type ReprocessResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+type Error struct {
+	Error string `json:"error"`
+}
+
+// Admin serves authenticated endpoints for inspecting and modifying the relayer state
+// without stopping the relayer
+type Admin struct {
+	token             string
+	blockStorer       RouteBlockStorer
+	retryEntryFetcher RetryEntryFetcher
+	executionFetcher  ExecutionFetcher
+	msgChan           chan []*message.Message
+}
+
+func NewAdmin(
+	token string,
+	blockStorer RouteBlockStorer,
+	retryEntryFetcher RetryEntryFetcher,
+	executionFetcher ExecutionFetcher,
+	msgChan chan []*message.Message,
+) *Admin {
+	return &Admin{
+		token:             token,
+		blockStorer:       blockStorer,
+		retryEntryFetcher: retryEntryFetcher,
+		executionFetcher:  executionFetcher,
+		msgChan:           msgChan,
+	}
+}
+
+// Handler returns the admin endpoints. Every request has to send the configured token
+// as the bearer token of the Authorization header.
+func (a *Admin) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /routes/{source}/{destination}/block", a.latestBlock)
+	mux.HandleFunc("PUT /routes/{source}/{destination}/block", a.setBlock)
+	mux.HandleFunc("POST /routes/{source}/{destination}/rewind", a.rewind)
+	mux.HandleFunc("POST /routes/{source}/{destination}/slots/{slot}/reprocess", a.reprocess)
+	mux.HandleFunc("GET /domains/{domain}/executions/pending", a.pendingExecutions)
+	mux.HandleFunc("GET /domains/{domain}/executions/retries", a.retriedExecutions)
+	mux.HandleFunc("GET /domains/{domain}/executions/failed", a.failedExecutions)
+	mux.HandleFunc("GET /executions/{messageID}", a.execution)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+			writeJSON(w, http.StatusUnauthorized, &Error{Error: "invalid admin token"})
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (a *Admin) latestBlock(w http.ResponseWriter, r *http.Request) {
+	source, destination, err := route(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+
+	block, err := a.blockStorer.LatestBlock(source, destination)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, &RouteBlock{Source: source, Destination: destination, Block: block.String()})
+}
+
+func (a *Admin) setBlock(w http.ResponseWriter, r *http.Request) {
+	source, destination, err := route(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+	req := &SetBlockRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+	block, ok := new(big.Int).SetString(req.Block, 10)
+	if !ok || block.Sign() < 0 {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: fmt.Sprintf("invalid block %s", req.Block)})
+		return
+	}
+
+	a.storeBlock(w, source, destination, block)
+}
+
+func (a *Admin) rewind(w http.ResponseWriter, r *http.Request) {
+	source, destination, err := route(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+	req := &RewindRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+
+	latestBlock, err := a.blockStorer.LatestBlock(source, destination)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+	block := new(big.Int).Sub(latestBlock, new(big.Int).SetUint64(req.Blocks))
+	if block.Sign() < 0 {
+		block = big.NewInt(0)
+	}
+
+	a.storeBlock(w, source, destination, block)
+}
+
+func (a *Admin) storeBlock(w http.ResponseWriter, source uint8, destination uint8, block *big.Int) {
+	err := a.blockStorer.StoreBlock(source, destination, block)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+
+	log.Info().Msgf("Latest block of route %d-%d set to %s by admin", source, destination, block)
+	writeJSON(w, http.StatusOK, &RouteBlock{Source: source, Destination: destination, Block: block.String()})
+}
+
+// reprocess sends a state root message for the slot that fetches events from the requested
+// start block up to the slot block. The latest block of the route is not changed.
+func (a *Admin) reprocess(w http.ResponseWriter, r *http.Request) {
+	source, destination, err := route(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+	slot, ok := new(big.Int).SetString(r.PathValue("slot"), 10)
+	if !ok || slot.Sign() < 0 {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: fmt.Sprintf("invalid slot %s", r.PathValue("slot"))})
+		return
+	}
+	req := &ReprocessRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+	startBlock, ok := new(big.Int).SetString(req.StartBlock, 10)
+	if !ok || startBlock.Sign() < 0 {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: fmt.Sprintf("invalid start block %s", req.StartBlock)})
+		return
+	}
+
+	msg := evmMessage.NewEvmStateRootMessage(destination, source, evmMessage.StateRootData{
+		Slot:       slot,
+		StartBlock: startBlock,
+	}, fmt.Sprintf("%s-reprocess", slot))
+	select {
+	case a.msgChan <- []*message.Message{msg}:
+		log.Info().Str("messageID", msg.ID).Msgf("Reprocessing slot %s of route %d-%d requested by admin", slot, source, destination)
+		writeJSON(w, http.StatusAccepted, &ReprocessResponse{MessageID: msg.ID})
+	case <-r.Context().Done():
+		writeJSON(w, http.StatusServiceUnavailable, &Error{Error: r.Context().Err().Error()})
+	}
+}
+
+// pendingExecutions returns sent execution transactions that are waiting for receipts
+func (a *Admin) pendingExecutions(w http.ResponseWriter, r *http.Request) {
+	domainID, err := parseDomainID(r.PathValue("domain"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+
+	res, err := a.executionFetcher.PendingTransactions(domainID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// retriedExecutions returns failed executions waiting to be retried
+func (a *Admin) retriedExecutions(w http.ResponseWriter, r *http.Request) {
+	a.retryEntries(w, r, a.retryEntryFetcher.RetryQueue)
+}
+
+func (a *Admin) failedExecutions(w http.ResponseWriter, r *http.Request) {
+	a.retryEntries(w, r, a.retryEntryFetcher.DeadLetters)
+}
+
+func (a *Admin) retryEntries(w http.ResponseWriter, r *http.Request, entries func(domainID uint8) ([]*store.RetryEntry, error)) {
+	domainID, err := parseDomainID(r.PathValue("domain"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &Error{Error: err.Error()})
+		return
+	}
+
+	res, err := entries(domainID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (a *Admin) execution(w http.ResponseWriter, r *http.Request) {
+	execution, err := a.executionFetcher.Execution(r.PathValue("messageID"))
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &Error{Error: err.Error()})
+		return
+	}
+	if execution == nil {
+		writeJSON(w, http.StatusNotFound, &Error{Error: fmt.Sprintf("execution of message %s not found", r.PathValue("messageID"))})
+		return
+	}
+	writeJSON(w, http.StatusOK, execution)
+}
+
+func route(r *http.Request) (uint8, uint8, error) {
+	source, err := parseDomainID(r.PathValue("source"))
+	if err != nil {
+		return 0, 0, err
+	}
+	destination, err := parseDomainID(r.PathValue("destination"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return source, destination, nil
+}
+
+func parseDomainID(value string) (uint8, error) {
+	domainID, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid domain ID %s", value)
+	}
+	return uint8(domainID), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// StartAdminEndpoint starts the admin endpoints on provided port
+func StartAdminEndpoint(port uint16, a *Admin) {
+	log.Info().Msgf("Starting admin endpoints on port %d", port)
+	err := http.ListenAndServe(fmt.Sprintf(":%d", port), a.Handler())
+	if err != nil {
+		log.Err(err).Msgf("Admin endpoint stopped")
+	}
+}
@@ -0,0 +1,191 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/relayer/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/admin"
+	evmMessage "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"go.uber.org/mock/gomock"
+)
+
+type AdminTestSuite struct {
+	suite.Suite
+
+	handler               http.Handler
+	mockBlockStorer       *mock.MockRouteBlockStorer
+	mockRetryEntryFetcher *mock.MockRetryEntryFetcher
+	mockExecutionFetcher  *mock.MockExecutionFetcher
+	msgChan               chan []*message.Message
+}
+
+func TestRunAdminTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminTestSuite))
+}
+
+func (s *AdminTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBlockStorer = mock.NewMockRouteBlockStorer(ctrl)
+	s.mockRetryEntryFetcher = mock.NewMockRetryEntryFetcher(ctrl)
+	s.mockExecutionFetcher = mock.NewMockExecutionFetcher(ctrl)
+	s.msgChan = make(chan []*message.Message, 1)
+	s.handler = admin.NewAdmin("secret", s.mockBlockStorer, s.mockRetryEntryFetcher, s.mockExecutionFetcher, s.msgChan).Handler()
+}
+
+func (s *AdminTestSuite) request(method string, path string, body string, token string) (int, string) {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	return recorder.Code, recorder.Body.String()
+}
+
+func (s *AdminTestSuite) Test_InvalidToken() {
+	code, _ := s.request(http.MethodGet, "/routes/1/2/block", "", "invalid")
+
+	s.Equal(code, http.StatusUnauthorized)
+}
+
+func (s *AdminTestSuite) Test_LatestBlock_InvalidRoute() {
+	code, _ := s.request(http.MethodGet, "/routes/1/300/block", "", "secret")
+
+	s.Equal(code, http.StatusBadRequest)
+}
+
+func (s *AdminTestSuite) Test_LatestBlock_Successful() {
+	s.mockBlockStorer.EXPECT().LatestBlock(uint8(1), uint8(2)).Return(big.NewInt(100), nil)
+
+	code, body := s.request(http.MethodGet, "/routes/1/2/block", "", "secret")
+
+	s.Equal(code, http.StatusOK)
+	s.JSONEq(body, `{"source":1,"destination":2,"block":"100"}`)
+}
+
+func (s *AdminTestSuite) Test_SetBlock_InvalidBlock() {
+	code, _ := s.request(http.MethodPut, "/routes/1/2/block", `{"block":"invalid"}`, "secret")
+
+	s.Equal(code, http.StatusBadRequest)
+}
+
+func (s *AdminTestSuite) Test_SetBlock_Successful() {
+	s.mockBlockStorer.EXPECT().StoreBlock(uint8(1), uint8(2), big.NewInt(50)).Return(nil)
+
+	code, body := s.request(http.MethodPut, "/routes/1/2/block", `{"block":"50"}`, "secret")
+
+	s.Equal(code, http.StatusOK)
+	s.JSONEq(body, `{"source":1,"destination":2,"block":"50"}`)
+}
+
+func (s *AdminTestSuite) Test_Rewind_Successful() {
+	s.mockBlockStorer.EXPECT().LatestBlock(uint8(1), uint8(2)).Return(big.NewInt(100), nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(uint8(1), uint8(2), big.NewInt(70)).Return(nil)
+
+	code, _ := s.request(http.MethodPost, "/routes/1/2/rewind", `{"blocks":30}`, "secret")
+
+	s.Equal(code, http.StatusOK)
+}
+
+func (s *AdminTestSuite) Test_Rewind_BelowZero() {
+	s.mockBlockStorer.EXPECT().LatestBlock(uint8(1), uint8(2)).Return(big.NewInt(10), nil)
+	s.mockBlockStorer.EXPECT().StoreBlock(uint8(1), uint8(2), big.NewInt(0)).Return(nil)
+
+	code, _ := s.request(http.MethodPost, "/routes/1/2/rewind", `{"blocks":30}`, "secret")
+
+	s.Equal(code, http.StatusOK)
+}
+
+func (s *AdminTestSuite) Test_Reprocess_MissingStartBlock() {
+	code, _ := s.request(http.MethodPost, "/routes/1/2/slots/1000/reprocess", `{}`, "secret")
+
+	s.Equal(code, http.StatusBadRequest)
+	s.Equal(len(s.msgChan), 0)
+}
+
+func (s *AdminTestSuite) Test_Reprocess_Successful() {
+	code, _ := s.request(http.MethodPost, "/routes/1/2/slots/1000/reprocess", `{"startBlock":"60"}`, "secret")
+
+	s.Equal(code, http.StatusAccepted)
+	msgs := <-s.msgChan
+	s.Equal(msgs[0], evmMessage.NewEvmStateRootMessage(2, 1, evmMessage.StateRootData{
+		Slot:       big.NewInt(1000),
+		StartBlock: big.NewInt(60),
+	}, "1000-reprocess"))
+}
+
+func (s *AdminTestSuite) Test_PendingExecutions() {
+	s.mockExecutionFetcher.EXPECT().PendingTransactions(uint8(2)).Return([]*store.PendingTransaction{
+		{TxHash: common.Hash{1}, SentBlock: 100, Attempts: 1, Proposals: json.RawMessage(`[]`)},
+	}, nil)
+
+	code, body := s.request(http.MethodGet, "/domains/2/executions/pending", "", "secret")
+
+	s.Equal(code, http.StatusOK)
+	txs := []*store.PendingTransaction{}
+	s.Nil(json.Unmarshal([]byte(body), &txs))
+	s.Equal(len(txs), 1)
+	s.Equal(txs[0].TxHash, common.Hash{1})
+	s.Equal(txs[0].SentBlock, uint64(100))
+}
+
+func (s *AdminTestSuite) Test_RetriedExecutions() {
+	s.mockRetryEntryFetcher.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{
+		{Attempts: 1, Proposals: json.RawMessage(`[]`), Error: "error"},
+	}, nil)
+
+	code, body := s.request(http.MethodGet, "/domains/2/executions/retries", "", "secret")
+
+	s.Equal(code, http.StatusOK)
+	entries := []*store.RetryEntry{}
+	s.Nil(json.Unmarshal([]byte(body), &entries))
+	s.Equal(len(entries), 1)
+	s.Equal(entries[0].Error, "error")
+}
+
+func (s *AdminTestSuite) Test_FailedExecutions() {
+	s.mockRetryEntryFetcher.EXPECT().DeadLetters(uint8(2)).Return(nil, fmt.Errorf("error"))
+
+	code, _ := s.request(http.MethodGet, "/domains/2/executions/failed", "", "secret")
+
+	s.Equal(code, http.StatusInternalServerError)
+}
+
+func (s *AdminTestSuite) Test_Execution_NotFound() {
+	s.mockExecutionFetcher.EXPECT().Execution("1-2-3").Return(nil, nil)
+
+	code, _ := s.request(http.MethodGet, "/executions/1-2-3", "", "secret")
+
+	s.Equal(code, http.StatusNotFound)
+}
+
+func (s *AdminTestSuite) Test_Execution_Successful() {
+	s.mockExecutionFetcher.EXPECT().Execution("1-2-3").Return(&store.Execution{
+		MessageID: "1-2-3",
+		Status:    store.FailedExecution,
+	}, nil)
+
+	code, body := s.request(http.MethodGet, "/executions/1-2-3", "", "secret")
+
+	s.Equal(code, http.StatusOK)
+	execution := &store.Execution{}
+	s.Nil(json.Unmarshal([]byte(body), execution))
+	s.Equal(execution.Status, store.FailedExecution)
+}
@@ -0,0 +1,94 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+const SSZ_CONTENT_TYPE = "application/octet-stream"
+
+// SSZResponse is SSZ encoded beacon data of the given fork version
+type SSZResponse struct {
+	Version spec.DataVersion
+	Data    []byte
+}
+
+// Client extends the beacon node HTTP service with requests returning raw SSZ encoded
+// states and blocks, so they can be decoded into the containers of the chain preset
+type Client struct {
+	*http.Service
+	client *nethttp.Client
+}
+
+func NewClient(service *http.Service, timeout time.Duration) *Client {
+	return &Client{
+		Service: service,
+		client: &nethttp.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// BeaconStateSSZ returns the SSZ encoded beacon state with the given state ID
+func (c *Client) BeaconStateSSZ(ctx context.Context, stateID string) (*SSZResponse, error) {
+	return c.get(ctx, "/eth/v2/debug/beacon/states/"+stateID)
+}
+
+// SignedBeaconBlockSSZ returns the SSZ encoded signed beacon block with the given block ID
+func (c *Client) SignedBeaconBlockSSZ(ctx context.Context, blockID string) (*SSZResponse, error) {
+	return c.get(ctx, "/eth/v2/beacon/blocks/"+blockID)
+}
+
+func (c *Client) get(ctx context.Context, path string) (*SSZResponse, error) {
+	endpoint, err := url.JoinPath(c.Address(), path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", SSZ_CONTENT_TYPE)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != nethttp.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("GET %s failed with status %d: %s", path, resp.StatusCode, body)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, SSZ_CONTENT_TYPE) {
+		return nil, fmt.Errorf("GET %s returned content type %q instead of SSZ", path, contentType)
+	}
+	var version spec.DataVersion
+	err = version.UnmarshalJSON([]byte(strconv.Quote(resp.Header.Get("Eth-Consensus-Version"))))
+	if err != nil {
+		return nil, fmt.Errorf("GET %s returned invalid consensus version: %w", path, err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSZResponse{
+		Version: version,
+		Data:    data,
+	}, nil
+}
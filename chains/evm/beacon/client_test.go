@@ -0,0 +1,93 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beacon_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+)
+
+type ClientTestSuite struct {
+	suite.Suite
+
+	server      *httptest.Server
+	client      *beacon.Client
+	contentType string
+	status      int
+}
+
+func TestRunClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+func (s *ClientTestSuite) SetupTest() {
+	s.contentType = beacon.SSZ_CONTENT_TYPE
+	s.status = nethttp.StatusOK
+	s.server = httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Header.Get("Accept") != beacon.SSZ_CONTENT_TYPE {
+			w.WriteHeader(nethttp.StatusNotAcceptable)
+			return
+		}
+
+		w.Header().Set("Content-Type", s.contentType)
+		w.Header().Set("Eth-Consensus-Version", "electra")
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+
+	service, err := http.New(context.Background(),
+		http.WithAddress(s.server.URL),
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAllowDelayedStart(true),
+	)
+	if err != nil {
+		panic(err)
+	}
+	s.client = beacon.NewClient(service.(*http.Service), time.Second)
+}
+
+func (s *ClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ClientTestSuite) Test_BeaconStateSSZ_ValidResponse() {
+	resp, err := s.client.BeaconStateSSZ(context.Background(), "100")
+
+	s.Nil(err)
+	s.Equal(resp.Version, spec.DataVersionElectra)
+	s.Equal(resp.Data, []byte("/eth/v2/debug/beacon/states/100"))
+}
+
+func (s *ClientTestSuite) Test_SignedBeaconBlockSSZ_ValidResponse() {
+	resp, err := s.client.SignedBeaconBlockSSZ(context.Background(), "100")
+
+	s.Nil(err)
+	s.Equal(resp.Version, spec.DataVersionElectra)
+	s.Equal(resp.Data, []byte("/eth/v2/beacon/blocks/100"))
+}
+
+func (s *ClientTestSuite) Test_SignedBeaconBlockSSZ_JSONResponse() {
+	s.contentType = "application/json"
+
+	_, err := s.client.SignedBeaconBlockSSZ(context.Background(), "100")
+
+	s.NotNil(err)
+}
+
+func (s *ClientTestSuite) Test_SignedBeaconBlockSSZ_FailedRequest() {
+	s.status = nethttp.StatusNotFound
+
+	_, err := s.client.SignedBeaconBlockSSZ(context.Background(), "100")
+
+	s.NotNil(err)
+}
@@ -5,6 +5,7 @@ package config
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/config"
@@ -15,8 +16,26 @@ type Spec string
 const (
 	MainnetSpec Spec = "mainnet"
 	GnosisSpec  Spec = "gnosis"
+	HoleskySpec Spec = "holesky"
+	SepoliaSpec Spec = "sepolia"
+	ChiadoSpec  Spec = "chiado"
 )
 
+// Wei is an amount in wei configured as a decimal string
+type Wei struct {
+	*big.Int
+}
+
+func (w *Wei) Decode(value string) error {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return fmt.Errorf("invalid wei amount %q", value)
+	}
+
+	w.Int = amount
+	return nil
+}
+
 type EVMConfig struct {
 	config.BaseNetworkConfig
 	BeaconEndpoint        string `split_words:"true"`
@@ -25,12 +44,15 @@ type EVMConfig struct {
 	Executor              string
 	Hashi                 string
 	Yaho                  string
+	Multicall             string
 	StartBlock            uint64   `split_words:"true"`
 	StateRootAddresses    []string `split_words:"true"`
 	SlotIndex             uint8    `required:"true" split_words:"true"`
 	MaxGasPrice           int64    `default:"500000000000" split_words:"true"`
 	GasMultiplier         float64  `default:"1" split_words:"true"`
 	GasIncreasePercentage int64    `default:"15" split_words:"true"`
+	GasLimitMargin        uint64   `default:"20" split_words:"true"`
+	MaxGasLimit           uint64   `default:"10000000" split_words:"true"`
 	BlockConfirmations    int64    `default:"1" split_words:"true"`
 	BlockInterval         int64    `default:"5" split_words:"true"`
 	BlockRetryInterval    uint64   `default:"5" split_words:"true"`
@@ -38,6 +60,17 @@ type EVMConfig struct {
 	Latest                bool     `default:"false" split_words:"true"`
 	GenericResources      []string `default:"0000000000000000000000000000000000000000000000000000000000000500" split_words:"true"`
 	Spec                  Spec     `default:"mainnet"`
+	SpecPath              string   `split_words:"true"`
+	StateStorePath        string   `split_words:"true"`
+	StateStoreSize        int      `default:"16" split_words:"true"`
+	DryRun                bool     `default:"false" split_words:"true"`
+	MaxExecutionAttempts  uint64   `default:"3" split_words:"true"`
+	RetryBackoff          uint64   `default:"30" split_words:"true"`
+	MaxRetryBackoff       uint64   `default:"1800" split_words:"true"`
+	MaxHeadAge            uint64   `default:"120" split_words:"true"`
+	MinBalance            Wei      `default:"0" split_words:"true"`
+	BalanceFloor          Wei      `default:"0" split_words:"true"`
+	BalanceCheckInterval  uint64   `default:"60" split_words:"true"`
 }
 
 // LoadEVMConfig loads EVM config from the environment and validates the fields
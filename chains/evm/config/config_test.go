@@ -4,6 +4,7 @@
 package config_test
 
 import (
+	"math/big"
 	"os"
 	"testing"
 
@@ -35,6 +36,28 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_MissingField() {
 	s.NotNil(err)
 }
 
+func (s *EVMConfigTestSuite) Test_LoadEVMConfig_InvalidMinBalance() {
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_ENDPOINT", "http://endpoint.com")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_KEY", "key")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_SLOT_INDEX", "1")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MIN_BALANCE", "1.5")
+
+	_, err := config.LoadEVMConfig(1)
+
+	s.NotNil(err)
+}
+
+func (s *EVMConfigTestSuite) Test_LoadEVMConfig_NegativeBalanceFloor() {
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_ENDPOINT", "http://endpoint.com")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_KEY", "key")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_SLOT_INDEX", "1")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_BALANCE_FLOOR", "-1")
+
+	_, err := config.LoadEVMConfig(1)
+
+	s.NotNil(err)
+}
+
 func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad_DefaultValues() {
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_ENDPOINT", "http://endpoint.com")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_KEY", "key")
@@ -66,6 +89,8 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad_DefaultValues() {
 		Hashi:                 "hashi",
 		GasMultiplier:         1,
 		GasIncreasePercentage: 15,
+		GasLimitMargin:        20,
+		MaxGasLimit:           10000000,
 		MaxGasPrice:           500000000000,
 		BeaconEndpoint:        "endpoint",
 		StateRootAddresses:    []string{"0x1", "0x2"},
@@ -78,6 +103,14 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad_DefaultValues() {
 		StartBlock:            120,
 		GenericResources:      []string{"0000000000000000000000000000000000000000000000000000000000000500"},
 		Spec:                  config.MainnetSpec,
+		StateStoreSize:        16,
+		MaxExecutionAttempts:  3,
+		RetryBackoff:          30,
+		MaxRetryBackoff:       1800,
+		MaxHeadAge:            120,
+		MinBalance:            config.Wei{Int: big.NewInt(0)},
+		BalanceFloor:          config.Wei{Int: big.NewInt(0)},
+		BalanceCheckInterval:  60,
 	})
 }
 
@@ -88,6 +121,7 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_EXECUTOR", "executor")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_YAHO", "yaho")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_HASHI", "hashi")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MULTICALL", "multicall")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_BEACON_ENDPOINT", "endpoint")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_ARCHIVE_BEACON_ENDPOINT", "archive")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MAX_GAS_PRICE", "1000")
@@ -96,6 +130,8 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_BLOCK_CONFIRMATIONS", "15")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_GAS_MULTIPLIER", "1")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_GAS_INCREASE_PERCENTAGE", "20")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_GAS_LIMIT_MARGIN", "10")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MAX_GAS_LIMIT", "5000000")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_2_ROUTER", "invalid")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_STATE_ROOT_ADDRESSES", "0x1,0x2")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_SLOT_INDEX", "1")
@@ -104,10 +140,22 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_LATEST", "true")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_GENERIC_RESOURCES", "1,2")
 	os.Setenv("INCLUSION_PROVER_DOMAINS_1_SPEC", "gnosis")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_SPEC_PATH", "./specs.json")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_STATE_STORE_PATH", "./states")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_STATE_STORE_SIZE", "4")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_DRY_RUN", "true")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MAX_EXECUTION_ATTEMPTS", "5")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_RETRY_BACKOFF", "10")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MAX_RETRY_BACKOFF", "600")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MAX_HEAD_AGE", "60")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_MIN_BALANCE", "20000000000000000000")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_BALANCE_FLOOR", "100000000000000000")
+	os.Setenv("INCLUSION_PROVER_DOMAINS_1_BALANCE_CHECK_INTERVAL", "30")
 
 	c, err := config.LoadEVMConfig(1)
 
 	s.Nil(err)
+	minBalance, _ := new(big.Int).SetString("20000000000000000000", 10)
 	s.Equal(c, &config.EVMConfig{
 		BaseNetworkConfig: baseConfig.BaseNetworkConfig{
 			Key:      "key",
@@ -117,6 +165,8 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 		Executor:              "executor",
 		GasMultiplier:         1,
 		GasIncreasePercentage: 20,
+		GasLimitMargin:        10,
+		MaxGasLimit:           5000000,
 		MaxGasPrice:           1000,
 		BeaconEndpoint:        "endpoint",
 		ArchiveBeaconEndpoint: "archive",
@@ -131,6 +181,18 @@ func (s *EVMConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 		GenericResources:      []string{"1", "2"},
 		Yaho:                  "yaho",
 		Hashi:                 "hashi",
+		Multicall:             "multicall",
 		Spec:                  config.GnosisSpec,
+		SpecPath:              "./specs.json",
+		StateStorePath:        "./states",
+		StateStoreSize:        4,
+		DryRun:                true,
+		MaxExecutionAttempts:  5,
+		RetryBackoff:          10,
+		MaxRetryBackoff:       600,
+		MaxHeadAge:            60,
+		MinBalance:            config.Wei{Int: minBalance},
+		BalanceFloor:          config.Wei{Int: big.NewInt(100000000000000000)},
+		BalanceCheckInterval:  30,
 	})
 }
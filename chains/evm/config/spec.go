@@ -0,0 +1,184 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// Preset defines which SSZ container types are used to build beacon state and block trees
+type Preset string
+
+const (
+	MainnetPreset Preset = "mainnet"
+	GnosisPreset  Preset = "gnosis"
+)
+
+// forks contains names of supported beacon forks in activation order
+var forks = []string{"capella", "deneb", "electra"}
+
+// ChainSpec contains beacon chain preset values required to generate and verify proofs
+type ChainSpec struct {
+	Preset                 Preset            `json:"preset"`
+	SlotsPerEpoch          uint64            `json:"slotsPerEpoch"`
+	SlotsPerHistoricalRoot uint64            `json:"slotsPerHistoricalRoot"`
+	SecondsPerSlot         uint64            `json:"secondsPerSlot"`
+	ForkEpochs             map[string]uint64 `json:"forkEpochs"`
+}
+
+var specs = map[Spec]ChainSpec{
+	MainnetSpec: {
+		Preset:                 MainnetPreset,
+		SlotsPerEpoch:          32,
+		SlotsPerHistoricalRoot: 8192,
+		SecondsPerSlot:         12,
+		ForkEpochs: map[string]uint64{
+			"capella": 194048,
+			"deneb":   269568,
+			"electra": 364032,
+		},
+	},
+	HoleskySpec: {
+		Preset:                 MainnetPreset,
+		SlotsPerEpoch:          32,
+		SlotsPerHistoricalRoot: 8192,
+		SecondsPerSlot:         12,
+		ForkEpochs: map[string]uint64{
+			"capella": 256,
+			"deneb":   29696,
+			"electra": 115968,
+		},
+	},
+	SepoliaSpec: {
+		Preset:                 MainnetPreset,
+		SlotsPerEpoch:          32,
+		SlotsPerHistoricalRoot: 8192,
+		SecondsPerSlot:         12,
+		ForkEpochs: map[string]uint64{
+			"capella": 56832,
+			"deneb":   132608,
+			"electra": 222464,
+		},
+	},
+	GnosisSpec: {
+		Preset:                 GnosisPreset,
+		SlotsPerEpoch:          16,
+		SlotsPerHistoricalRoot: 8192,
+		SecondsPerSlot:         5,
+		ForkEpochs: map[string]uint64{
+			"capella": 648704,
+			"deneb":   889856,
+			"electra": 1337856,
+		},
+	},
+	ChiadoSpec: {
+		Preset:                 GnosisPreset,
+		SlotsPerEpoch:          16,
+		SlotsPerHistoricalRoot: 8192,
+		SecondsPerSlot:         5,
+		ForkEpochs: map[string]uint64{
+			"capella": 244224,
+			"deneb":   516608,
+			"electra": 948224,
+		},
+	},
+}
+
+// LoadChainSpec returns the chain spec with the given name.
+// Specs from the JSON file at path, keyed by spec name, are added to the built-in
+// specs and override them if they share the name.
+func LoadChainSpec(name Spec, path string) (*ChainSpec, error) {
+	chainSpecs := make(map[Spec]ChainSpec)
+	for specName, chainSpec := range specs {
+		chainSpecs[specName] = chainSpec
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		customSpecs := make(map[Spec]ChainSpec)
+		err = json.Unmarshal(data, &customSpecs)
+		if err != nil {
+			return nil, err
+		}
+		for specName, chainSpec := range customSpecs {
+			chainSpecs[specName] = chainSpec
+		}
+	}
+
+	chainSpec, ok := chainSpecs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain spec %s", name)
+	}
+	err := chainSpec.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain spec %s: %w", name, err)
+	}
+
+	return &chainSpec, nil
+}
+
+// Fork returns the name of the latest fork activated at the given slot or
+// an empty string if no configured fork is active. Forks activated at the same
+// epoch resolve to the later one.
+func (s *ChainSpec) Fork(slot uint64) string {
+	epoch := slot / s.SlotsPerEpoch
+	fork := ""
+	for _, name := range forks {
+		forkEpoch, ok := s.ForkEpochs[name]
+		if ok && forkEpoch <= epoch {
+			fork = name
+		}
+	}
+
+	return fork
+}
+
+// SlotDuration returns the time between two consecutive slots
+func (s *ChainSpec) SlotDuration() time.Duration {
+	return time.Duration(s.SecondsPerSlot) * time.Second
+}
+
+func (s *ChainSpec) validate() error {
+	if s.Preset != MainnetPreset && s.Preset != GnosisPreset {
+		return fmt.Errorf("unknown preset %s", s.Preset)
+	}
+	if s.SlotsPerEpoch == 0 {
+		return fmt.Errorf("slots per epoch not set")
+	}
+	if s.SecondsPerSlot == 0 {
+		return fmt.Errorf("seconds per slot not set")
+	}
+	if s.SlotsPerHistoricalRoot == 0 || s.SlotsPerHistoricalRoot&(s.SlotsPerHistoricalRoot-1) != 0 {
+		return fmt.Errorf("slots per historical root %d is not a power of two", s.SlotsPerHistoricalRoot)
+	}
+	if len(s.ForkEpochs) == 0 {
+		return fmt.Errorf("fork epochs not set")
+	}
+	for name := range s.ForkEpochs {
+		if !slices.Contains(forks, name) {
+			return fmt.Errorf("unknown fork %s", name)
+		}
+	}
+	previousFork := ""
+	for _, name := range forks {
+		forkEpoch, ok := s.ForkEpochs[name]
+		if !ok {
+			continue
+		}
+		if previousFork != "" && forkEpoch < s.ForkEpochs[previousFork] {
+			return fmt.Errorf(
+				"fork %s epoch %d is before %s epoch %d", name, forkEpoch, previousFork, s.ForkEpochs[previousFork])
+		}
+		previousFork = name
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
+)
+
+type ChainSpecTestSuite struct {
+	suite.Suite
+}
+
+func TestRunChainSpecTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainSpecTestSuite))
+}
+
+func (s *ChainSpecTestSuite) Test_LoadChainSpec_BuiltIn() {
+	chainSpec, err := config.LoadChainSpec(config.ChiadoSpec, "")
+
+	s.Nil(err)
+	s.Equal(chainSpec.Preset, config.GnosisPreset)
+	s.Equal(chainSpec.SlotsPerEpoch, uint64(16))
+	s.Equal(chainSpec.SlotDuration(), time.Second*5)
+}
+
+func (s *ChainSpecTestSuite) Test_LoadChainSpec_UnknownSpec() {
+	_, err := config.LoadChainSpec("devnet", "")
+
+	s.NotNil(err)
+}
+
+func (s *ChainSpecTestSuite) Test_LoadChainSpec_CustomSpec() {
+	path := filepath.Join(s.T().TempDir(), "specs.json")
+	err := os.WriteFile(path, []byte(`{
+		"devnet": {
+			"preset": "mainnet",
+			"slotsPerEpoch": 8,
+			"slotsPerHistoricalRoot": 64,
+			"secondsPerSlot": 6,
+			"forkEpochs": {"capella": 0, "deneb": 10}
+		}
+	}`), 0600)
+	s.Nil(err)
+
+	chainSpec, err := config.LoadChainSpec("devnet", path)
+
+	s.Nil(err)
+	s.Equal(chainSpec, &config.ChainSpec{
+		Preset:                 config.MainnetPreset,
+		SlotsPerEpoch:          8,
+		SlotsPerHistoricalRoot: 64,
+		SecondsPerSlot:         6,
+		ForkEpochs: map[string]uint64{
+			"capella": 0,
+			"deneb":   10,
+		},
+	})
+}
+
+func (s *ChainSpecTestSuite) Test_LoadChainSpec_InvalidCustomSpec() {
+	path := filepath.Join(s.T().TempDir(), "specs.json")
+	err := os.WriteFile(path, []byte(`{
+		"mainnet": {
+			"preset": "mainnet",
+			"slotsPerEpoch": 32,
+			"slotsPerHistoricalRoot": 1000
+		}
+	}`), 0600)
+	s.Nil(err)
+
+	_, err = config.LoadChainSpec(config.MainnetSpec, path)
+
+	s.NotNil(err)
+}
+
+func (s *ChainSpecTestSuite) Test_Fork() {
+	chainSpec, err := config.LoadChainSpec(config.MainnetSpec, "")
+	s.Nil(err)
+
+	s.Equal(chainSpec.Fork(0), "")
+	s.Equal(chainSpec.Fork(194048*32), "capella")
+	s.Equal(chainSpec.Fork(269568*32-1), "capella")
+	s.Equal(chainSpec.Fork(269568*32), "deneb")
+}
+
+func (s *ChainSpecTestSuite) Test_Fork_SameEpoch() {
+	chainSpec := &config.ChainSpec{
+		Preset:                 config.MainnetPreset,
+		SlotsPerEpoch:          32,
+		SlotsPerHistoricalRoot: 8192,
+		ForkEpochs: map[string]uint64{
+			"capella": 0,
+			"deneb":   0,
+			"electra": 0,
+		},
+	}
+
+	for i := 0; i < 100; i++ {
+		s.Equal(chainSpec.Fork(0), "electra")
+	}
+}
+
+func (s *ChainSpecTestSuite) Test_LoadChainSpec_InvalidForkEpochs() {
+	path := filepath.Join(s.T().TempDir(), "specs.json")
+	err := os.WriteFile(path, []byte(`{
+		"noForks": {
+			"preset": "mainnet",
+			"slotsPerEpoch": 8,
+			"slotsPerHistoricalRoot": 64,
+			"secondsPerSlot": 6
+		},
+		"unknownFork": {
+			"preset": "mainnet",
+			"slotsPerEpoch": 8,
+			"slotsPerHistoricalRoot": 64,
+			"secondsPerSlot": 6,
+			"forkEpochs": {"capella": 0, "fulu": 10}
+		},
+		"unorderedForks": {
+			"preset": "mainnet",
+			"slotsPerEpoch": 8,
+			"slotsPerHistoricalRoot": 64,
+			"secondsPerSlot": 6,
+			"forkEpochs": {"capella": 10, "deneb": 5}
+		}
+	}`), 0600)
+	s.Nil(err)
+
+	_, err = config.LoadChainSpec("noForks", path)
+	s.NotNil(err)
+	_, err = config.LoadChainSpec("unknownFork", path)
+	s.NotNil(err)
+	_, err = config.LoadChainSpec("unorderedForks", path)
+	s.NotNil(err)
+}
@@ -4,9 +4,11 @@
 package contracts
 
 import (
+	"context"
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
 	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -29,6 +31,7 @@ type ExecutorProposal struct {
 
 type Executor struct {
 	coreContracts.Contract
+	client client.Client
 }
 
 func NewExecutorContract(
@@ -39,6 +42,7 @@ func NewExecutorContract(
 	a, _ := ethereumABI.JSON(strings.NewReader(abi.ExecutorABI))
 	return &Executor{
 		Contract: coreContracts.NewContract(address, a, nil, client, transactor),
+		client:   client,
 	}
 }
 
@@ -55,6 +59,35 @@ func (c *Executor) ExecuteProposals(
 	)
 }
 
+// ExecuteProposalsCalldata returns the calldata of the transaction sent by ExecuteProposals
+func (c *Executor) ExecuteProposalsCalldata(
+	props []ExecutorProposal,
+	accountProof [][]byte,
+	slot *big.Int,
+) ([]byte, error) {
+	return c.PackMethod("executeProposals", props, accountProof, slot)
+}
+
+// SimulateExecuteProposals runs executeProposals with eth_call against the latest block.
+// Reverts are returned as RevertError with the reason decoded from the executor custom errors.
+func (c *Executor) SimulateExecuteProposals(
+	props []ExecutorProposal,
+	accountProof [][]byte,
+	slot *big.Int,
+) error {
+	input, err := c.ExecuteProposalsCalldata(props, accountProof, slot)
+	if err != nil {
+		return err
+	}
+
+	msg := ethereum.CallMsg{From: c.client.From(), To: c.ContractAddress(), Data: input}
+	_, err = c.client.CallContract(context.Background(), client.ToCallArg(msg), nil)
+	if err != nil {
+		return decodeRevert(c.ABI, err)
+	}
+	return nil
+}
+
 func (c *Executor) IsProposalExecuted(p *proposal.Proposal) (bool, error) {
 	t := p.Data.(message.TransferData)
 	res, err := c.CallContract("isProposalExecuted", p.Source, big.NewInt(int64(t.Deposit.DepositNonce)))
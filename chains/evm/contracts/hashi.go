@@ -6,6 +6,7 @@ package contracts
 import (
 	"math/big"
 	"strings"
+	"sync"
 
 	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,6 +19,8 @@ import (
 
 type HashiAdapterContract struct {
 	coreContracts.Contract
+	sourceChainID *big.Int
+	lock          sync.Mutex
 }
 
 func NewHashiAdapterContract(
@@ -48,6 +51,53 @@ func (c *HashiAdapterContract) VerifyAndStoreDispatchedMessage(
 	)
 }
 
+// SourceChainID returns the ID of the chain the adapter stores messages from
+func (c *HashiAdapterContract) SourceChainID() (*big.Int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.sourceChainID != nil {
+		return c.sourceChainID, nil
+	}
+
+	res, err := c.CallContract("SOURCE_CHAIN_ID")
+	if err != nil {
+		return nil, err
+	}
+	c.sourceChainID = *ethereumABI.ConvertType(res[0], new(*big.Int)).(**big.Int)
+	return c.sourceChainID, nil
+}
+
+// MessageHash returns the hash of the dispatched message stored by the adapter
+// or an empty hash if the message is not stored
+func (c *HashiAdapterContract) MessageHash(messageID *big.Int) ([32]byte, error) {
+	sourceChainID, err := c.SourceChainID()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	res, err := c.CallContract("getHash", sourceChainID, messageID)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return *ethereumABI.ConvertType(res[0], new([32]byte)).(*[32]byte), nil
+}
+
+// VerifyAndStoreDispatchedMessageCalldata returns the calldata of the transaction sent by VerifyAndStoreDispatchedMessage
+func (c *HashiAdapterContract) VerifyAndStoreDispatchedMessageCalldata(
+	srcSlot uint64,
+	txSlot uint64,
+	receiptsRootProof [][]byte,
+	receiptsRoot [32]byte,
+	receiptProof [][]byte,
+	txIndexRLPEncoded []byte,
+	logIndex *big.Int,
+) ([]byte, error) {
+	return c.PackMethod(
+		"verifyAndStoreDispatchedMessage",
+		srcSlot, txSlot, Bytes32Array(receiptsRootProof), SliceTo32Bytes(receiptsRoot[:]), receiptProof, txIndexRLPEncoded, logIndex,
+	)
+}
+
 func SliceTo32Bytes(in []byte) [32]byte {
 	var res [32]byte
 	copy(res[:], in)
@@ -0,0 +1,67 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package contracts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sygmaprotocol/sygma-core/chains/evm/client"
+	coreContracts "github.com/sygmaprotocol/sygma-core/chains/evm/contracts"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/abi"
+)
+
+type Call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// Multicall is a Multicall3 contract used to send multiple calls in a single transaction
+type Multicall struct {
+	coreContracts.Contract
+	client client.Client
+}
+
+func NewMulticallContract(
+	address common.Address,
+	client client.Client,
+	transactor transactor.Transactor,
+) *Multicall {
+	a, _ := ethereumABI.JSON(strings.NewReader(abi.MulticallABI))
+	return &Multicall{
+		Contract: coreContracts.NewContract(address, a, nil, client, transactor),
+		client:   client,
+	}
+}
+
+func (c *Multicall) Aggregate3(calls []Call3, opts transactor.TransactOptions) (*common.Hash, error) {
+	return c.ExecuteTransaction("aggregate3", opts, calls)
+}
+
+// Aggregate3Calldata returns the calldata of the transaction sent by Aggregate3
+func (c *Multicall) Aggregate3Calldata(calls []Call3) ([]byte, error) {
+	return c.PackMethod("aggregate3", calls)
+}
+
+// SimulateAggregate3 runs aggregate3 with eth_call against the latest block.
+// Reverts are returned as RevertError.
+func (c *Multicall) SimulateAggregate3(calls []Call3) error {
+	input, err := c.Aggregate3Calldata(calls)
+	if err != nil {
+		return err
+	}
+
+	msg := ethereum.CallMsg{From: c.client.From(), To: c.ContractAddress(), Data: input}
+	_, err = c.client.CallContract(context.Background(), client.ToCallArg(msg), nil)
+	if err != nil {
+		return DecodeRevert(err)
+	}
+	return nil
+}
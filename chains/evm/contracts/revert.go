@@ -0,0 +1,97 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/abi"
+)
+
+// errorsABI contains the custom errors of all contracts the relayer sends transactions to
+var errorsABI = func() ethereumABI.ABI {
+	errorsABI := ethereumABI.ABI{Errors: make(map[string]ethereumABI.Error)}
+	for _, contractABI := range []string{abi.ExecutorABI, abi.HashiAdapterABI} {
+		a, _ := ethereumABI.JSON(strings.NewReader(contractABI))
+		for name, abiErr := range a.Errors {
+			errorsABI.Errors[name] = abiErr
+		}
+	}
+	return errorsABI
+}()
+
+// RevertError is returned when a simulated contract call reverts
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// DecodeRevert converts eth_call errors of executor and hashi adapter calls into a RevertError
+func DecodeRevert(err error) error {
+	return decodeRevert(errorsABI, err)
+}
+
+// decodeRevert converts eth_call errors that carry revert data into a RevertError
+// with the reason decoded from the contract custom errors or the revert string.
+// Errors that are not reverts are returned unchanged.
+func decodeRevert(a ethereumABI.ABI, err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return &RevertError{Reason: err.Error()}
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(data) < 4 {
+		return &RevertError{Reason: err.Error()}
+	}
+
+	if reason, unpackErr := ethereumABI.UnpackRevert(data); unpackErr == nil {
+		return &RevertError{Reason: reason}
+	}
+	for _, abiErr := range a.Errors {
+		if !bytes.Equal(abiErr.ID[:4], data[:4]) {
+			continue
+		}
+
+		values, unpackErr := abiErr.Unpack(data)
+		if unpackErr != nil {
+			return &RevertError{Reason: abiErr.Name}
+		}
+		return &RevertError{Reason: fmt.Sprintf("%s(%s)", abiErr.Name, formatErrorValues(values))}
+	}
+
+	return &RevertError{Reason: fmt.Sprintf("unknown error %s", hexutil.Encode(data))}
+}
+
+func formatErrorValues(values interface{}) string {
+	args, ok := values.([]interface{})
+	if !ok {
+		return fmt.Sprint(values)
+	}
+
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case [32]byte:
+			formatted[i] = hexutil.Encode(v[:])
+		case [4]byte:
+			formatted[i] = hexutil.Encode(v[:])
+		default:
+			formatted[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,128 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type BalanceClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	From() common.Address
+}
+
+type BalanceMetrics interface {
+	TrackSignerBalance(domainID uint8, balance *big.Int)
+	TrackExecutionPaused(domainID uint8, paused bool)
+}
+
+// EVMBalanceMonitor periodically fetches the signer balance. Execution is paused while
+// the balance is below the floor and the health check fails while it is below the minimum balance.
+type EVMBalanceMonitor struct {
+	domainID   uint8
+	client     BalanceClient
+	metrics    BalanceMetrics
+	minBalance *big.Int
+	floor      *big.Int
+	balance    *big.Int
+	err        error
+	lock       sync.RWMutex
+}
+
+func NewEVMBalanceMonitor(
+	domainID uint8,
+	client BalanceClient,
+	metrics BalanceMetrics,
+	minBalance *big.Int,
+	floor *big.Int,
+) *EVMBalanceMonitor {
+	return &EVMBalanceMonitor{
+		domainID:   domainID,
+		client:     client,
+		metrics:    metrics,
+		minBalance: minBalance,
+		floor:      floor,
+	}
+}
+
+// Run updates the signer balance every interval until the context is cancelled
+func (m *EVMBalanceMonitor) Run(ctx context.Context, interval time.Duration) {
+	m.update(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.update(ctx)
+		}
+	}
+}
+
+// Paused returns true if the last fetched signer balance is below the floor
+func (m *EVMBalanceMonitor) Paused() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.balance != nil && m.balance.Cmp(m.floor) < 0
+}
+
+// Check returns an error if the signer balance is unknown or below the minimum balance
+func (m *EVMBalanceMonitor) Check(ctx context.Context) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	if m.balance == nil {
+		return fmt.Errorf("signer balance not fetched yet")
+	}
+	if m.balance.Cmp(m.minBalance) < 0 {
+		return fmt.Errorf("signer %s balance %s is below %s", m.client.From(), m.balance, m.minBalance)
+	}
+	return nil
+}
+
+func (m *EVMBalanceMonitor) update(ctx context.Context) {
+	balance, err := m.client.BalanceAt(ctx, m.client.From(), nil)
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", m.domainID).Msgf("Failed fetching signer balance")
+	} else {
+		m.metrics.TrackSignerBalance(m.domainID, balance)
+	}
+
+	wasPaused := m.Paused()
+	m.lock.Lock()
+	m.err = err
+	if err == nil {
+		m.balance = balance
+	}
+	m.lock.Unlock()
+	if err != nil {
+		return
+	}
+
+	paused := m.Paused()
+	m.metrics.TrackExecutionPaused(m.domainID, paused)
+	switch {
+	case paused && !wasPaused:
+		log.Error().Uint8("domainID", m.domainID).Msgf(
+			"Signer %s balance %s is below floor %s, pausing execution", m.client.From(), balance, m.floor)
+	case !paused && wasPaused:
+		log.Info().Uint8("domainID", m.domainID).Msgf(
+			"Signer %s balance %s is above floor %s, resuming execution", m.client.From(), balance, m.floor)
+	case balance.Cmp(m.minBalance) < 0:
+		log.Warn().Uint8("domainID", m.domainID).Msgf(
+			"Signer %s balance %s is below %s", m.client.From(), balance, m.minBalance)
+	}
+}
@@ -0,0 +1,93 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type BalanceMonitorTestSuite struct {
+	suite.Suite
+
+	monitor     *executor.EVMBalanceMonitor
+	mockClient  *mock.MockBalanceClient
+	mockMetrics *mock.MockBalanceMetrics
+	ctx         context.Context
+}
+
+func TestRunBalanceMonitorTestSuite(t *testing.T) {
+	suite.Run(t, new(BalanceMonitorTestSuite))
+}
+
+func (s *BalanceMonitorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock.NewMockBalanceClient(ctrl)
+	s.mockClient.EXPECT().From().Return(common.HexToAddress("0x1")).AnyTimes()
+	s.mockMetrics = mock.NewMockBalanceMetrics(ctrl)
+	s.monitor = executor.NewEVMBalanceMonitor(1, s.mockClient, s.mockMetrics, big.NewInt(200), big.NewInt(100))
+
+	// cancelled context makes Run return after the initial update
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.ctx = ctx
+}
+
+func (s *BalanceMonitorTestSuite) Test_Check_BalanceNotFetched() {
+	err := s.monitor.Check(context.Background())
+
+	s.NotNil(err)
+	s.False(s.monitor.Paused())
+}
+
+func (s *BalanceMonitorTestSuite) Test_Run_FetchFails() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(nil, fmt.Errorf("error"))
+
+	s.monitor.Run(s.ctx, time.Minute)
+
+	s.NotNil(s.monitor.Check(context.Background()))
+	s.False(s.monitor.Paused())
+}
+
+func (s *BalanceMonitorTestSuite) Test_Run_BalanceAboveMinimum() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(300), nil)
+	s.mockMetrics.EXPECT().TrackSignerBalance(uint8(1), big.NewInt(300))
+	s.mockMetrics.EXPECT().TrackExecutionPaused(uint8(1), false)
+
+	s.monitor.Run(s.ctx, time.Minute)
+
+	s.Nil(s.monitor.Check(context.Background()))
+	s.False(s.monitor.Paused())
+}
+
+func (s *BalanceMonitorTestSuite) Test_Run_BalanceBelowMinimum() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(150), nil)
+	s.mockMetrics.EXPECT().TrackSignerBalance(uint8(1), big.NewInt(150))
+	s.mockMetrics.EXPECT().TrackExecutionPaused(uint8(1), false)
+
+	s.monitor.Run(s.ctx, time.Minute)
+
+	s.NotNil(s.monitor.Check(context.Background()))
+	s.False(s.monitor.Paused())
+}
+
+func (s *BalanceMonitorTestSuite) Test_Run_BalanceBelowFloor() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(50), nil)
+	s.mockMetrics.EXPECT().TrackSignerBalance(uint8(1), big.NewInt(50))
+	s.mockMetrics.EXPECT().TrackExecutionPaused(uint8(1), true)
+
+	s.monitor.Run(s.ctx, time.Minute)
+
+	s.NotNil(s.monitor.Check(context.Background()))
+	s.True(s.monitor.Paused())
+}
@@ -4,8 +4,10 @@
 package executor
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
@@ -20,16 +22,58 @@ const TRANSFER_GAS_COST = 600000
 const HASHI_GAS_COST = 3000000
 
 type Batch struct {
-	proposals []contracts.ExecutorProposal
-	gasLimit  uint64
+	props        []*proposal.Proposal
+	proposals    []contracts.ExecutorProposal
+	proposalsGas []uint64
+	gasLimit     uint64
+}
+
+func newBatch() *Batch {
+	return &Batch{
+		props:        make([]*proposal.Proposal, 0),
+		proposals:    make([]contracts.ExecutorProposal, 0),
+		proposalsGas: make([]uint64, 0),
+		gasLimit:     0,
+	}
+}
+
+func (b *Batch) add(prop *proposal.Proposal, executorProposal contracts.ExecutorProposal, gas uint64) {
+	b.props = append(b.props, prop)
+	b.proposals = append(b.proposals, executorProposal)
+	b.proposalsGas = append(b.proposalsGas, gas)
+	b.gasLimit += gas
+}
+
+// split returns a batch for each proposal of the batch
+func (b *Batch) split() []*Batch {
+	batches := make([]*Batch, len(b.proposals))
+	for i := range b.proposals {
+		batches[i] = newBatch()
+		batches[i].add(b.props[i], b.proposals[i], b.proposalsGas[i])
+	}
+	return batches
 }
 
 type ExecutorContract interface {
+	ContractAddress() *common.Address
 	IsProposalExecuted(p *proposal.Proposal) (bool, error)
+	SimulateExecuteProposals(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int) error
+	ExecuteProposalsCalldata(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int) ([]byte, error)
 	ExecuteProposals(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int, opts transactor.TransactOptions) (*common.Hash, error)
 }
 
 type HashiContract interface {
+	ContractAddress() *common.Address
+	MessageHash(messageID *big.Int) ([32]byte, error)
+	VerifyAndStoreDispatchedMessageCalldata(
+		srcSlot uint64,
+		txSlot uint64,
+		receiptsRootProof [][]byte,
+		receiptsRoot [32]byte,
+		receiptProof [][]byte,
+		txIndexRLPEncoded []byte,
+		logIndex *big.Int,
+	) ([]byte, error)
 	VerifyAndStoreDispatchedMessage(
 		srcSlot uint64,
 		txSlot uint64,
@@ -42,35 +86,190 @@ type HashiContract interface {
 	) (*common.Hash, error)
 }
 
+type MulticallContract interface {
+	ContractAddress() *common.Address
+	SimulateAggregate3(calls []contracts.Call3) error
+	Aggregate3Calldata(calls []contracts.Call3) ([]byte, error)
+	Aggregate3(calls []contracts.Call3, opts transactor.TransactOptions) (*common.Hash, error)
+}
+
+type GasEstimator interface {
+	EstimateGas(to *common.Address, calldata []byte, fallbackGas uint64) uint64
+}
+
+type ExecutionWatcher interface {
+	Watch(
+		props []*proposal.Proposal,
+		txHash common.Hash,
+		attempts uint64,
+		onFailure func(props []*proposal.Proposal, attempts uint64, err error))
+	Resume(onFailure func(props []*proposal.Proposal, attempts uint64, err error)) error
+}
+
+type RetryQueue interface {
+	Push(props []*proposal.Proposal, attempts uint64, err error) error
+}
+
+type BalanceMonitor interface {
+	Paused() bool
+}
+
+type ExecutorMetrics interface {
+	TrackExecutionsSubmitted(domainID uint8, count int)
+}
+
 type EVMExecutor struct {
 	domainID          uint8
 	executor          ExecutorContract
 	hashiAdapter      HashiContract
+	multicall         MulticallContract
+	gasEstimator      GasEstimator
+	watcher           ExecutionWatcher
+	retryQueue        RetryQueue
+	balanceMonitor    BalanceMonitor
+	metrics           ExecutorMetrics
 	transactionMaxGas uint64
+	dryRun            bool
 }
 
-func NewEVMExecutor(domainID uint8, executor ExecutorContract, hashiAdapter HashiContract) *EVMExecutor {
+// NewEVMExecutor creates the executor of the domain. Execution status is not tracked
+// if the execution watcher is nil and failed executions are not retried if the retry queue is nil.
+// Hashi messages are sent in separate transactions if the multicall contract is nil and execution
+// is never paused if the balance monitor is nil. In dry-run mode transactions are only simulated, so
+// executions are not counted as submitted.
+func NewEVMExecutor(
+	domainID uint8,
+	executor ExecutorContract,
+	hashiAdapter HashiContract,
+	multicall MulticallContract,
+	gasEstimator GasEstimator,
+	watcher ExecutionWatcher,
+	retryQueue RetryQueue,
+	balanceMonitor BalanceMonitor,
+	metrics ExecutorMetrics,
+	transactionMaxGas uint64,
+	dryRun bool,
+) *EVMExecutor {
 	return &EVMExecutor{
 		domainID:          domainID,
 		executor:          executor,
 		hashiAdapter:      hashiAdapter,
-		transactionMaxGas: 10000000,
+		multicall:         multicall,
+		gasEstimator:      gasEstimator,
+		watcher:           watcher,
+		retryQueue:        retryQueue,
+		balanceMonitor:    balanceMonitor,
+		metrics:           metrics,
+		transactionMaxGas: transactionMaxGas,
+		dryRun:            dryRun,
 	}
 }
 
 func (e *EVMExecutor) Execute(props []*proposal.Proposal) error {
+	return e.execute(props, 0)
+}
+
+// Retry executes proposals from the retry queue that previously failed the given number of attempts
+func (e *EVMExecutor) Retry(props []*proposal.Proposal, attempts uint64) {
+	log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Retrying execution of %d proposals", len(props))
+	err := e.execute(props, attempts)
+	if err != nil {
+		log.Err(err).Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Failed retrying proposals execution")
+	}
+}
+
+func (e *EVMExecutor) execute(props []*proposal.Proposal, attempts uint64) error {
+	if e.balanceMonitor != nil && e.balanceMonitor.Paused() {
+		return e.pause(props, attempts)
+	}
+
 	switch prop := props[0]; prop.Type {
 	case message.EVMTransferProposal:
-		return e.transfer(props)
+		return e.transfer(props, attempts)
 	case message.HashiProposal:
-		return e.storeMessage(props)
+		return e.storeMessages(props, attempts)
 	default:
 		return fmt.Errorf("no executor configured for prop type %s", prop.Type)
 	}
 }
 
-func (e *EVMExecutor) storeMessage(props []*proposal.Proposal) error {
-	data := props[0].Data.(message.HashiData)
+// hashiMessage is a hashi proposal with the packed verifyAndStoreDispatchedMessage calldata
+type hashiMessage struct {
+	prop     *proposal.Proposal
+	calldata []byte
+}
+
+// storeMessages stores all hashi messages that are not yet stored by the adapter.
+// If a multicall contract is configured, messages are batched into aggregate3 transactions.
+func (e *EVMExecutor) storeMessages(props []*proposal.Proposal, attempts uint64) error {
+	errs := make([]error, 0)
+	batches := make([][]*hashiMessage, 1)
+	batchGas := uint64(0)
+	for _, prop := range props {
+		msg, err := e.hashiMessage(prop)
+		if err != nil {
+			errs = append(errs, e.retry([]*proposal.Proposal{prop}, attempts, err))
+			continue
+		}
+		if msg == nil {
+			continue
+		}
+
+		if len(batches[len(batches)-1]) > 0 && batchGas+HASHI_GAS_COST >= e.transactionMaxGas {
+			batches = append(batches, make([]*hashiMessage, 0))
+			batchGas = 0
+		}
+		batches[len(batches)-1] = append(batches[len(batches)-1], msg)
+		batchGas += HASHI_GAS_COST
+	}
+
+	for _, batch := range batches {
+		if e.multicall == nil || len(batch) <= 1 {
+			for _, msg := range batch {
+				errs = append(errs, e.storeMessage(msg, attempts))
+			}
+			continue
+		}
+
+		errs = append(errs, e.aggregateMessages(batch, attempts))
+	}
+	return errors.Join(errs...)
+}
+
+// hashiMessage packs the calldata of the hashi proposal or returns nil if the message
+// is already stored by the adapter
+func (e *EVMExecutor) hashiMessage(prop *proposal.Proposal) (*hashiMessage, error) {
+	data := prop.Data.(message.HashiData)
+	if data.DispatchedMessageID != nil {
+		messageHash, err := e.hashiAdapter.MessageHash(data.DispatchedMessageID)
+		if err != nil {
+			return nil, err
+		}
+		if messageHash != [32]byte{} {
+			log.Info().Str("messageID", prop.MessageID).Uint8("domainID", e.domainID).Msgf(
+				"Hashi message %s already stored", data.DispatchedMessageID)
+			return nil, nil
+		}
+	}
+
+	calldata, err := e.hashiAdapter.VerifyAndStoreDispatchedMessageCalldata(
+		data.SrcSlot.Uint64(),
+		data.TxSlot.Uint64(),
+		data.ReceiptRootProof,
+		data.ReceiptRoot,
+		data.ReceiptProof,
+		data.TxIndexRLPEncoded,
+		data.LogIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &hashiMessage{prop: prop, calldata: calldata}, nil
+}
+
+func (e *EVMExecutor) storeMessage(msg *hashiMessage, attempts uint64) error {
+	props := []*proposal.Proposal{msg.prop}
+	data := msg.prop.Data.(message.HashiData)
 	hash, err := e.hashiAdapter.VerifyAndStoreDispatchedMessage(
 		data.SrcSlot.Uint64(),
 		data.TxSlot.Uint64(),
@@ -80,23 +279,78 @@ func (e *EVMExecutor) storeMessage(props []*proposal.Proposal) error {
 		data.TxIndexRLPEncoded,
 		data.LogIndex,
 		transactor.TransactOptions{
-			GasLimit: HASHI_GAS_COST,
+			GasLimit: e.gasEstimator.EstimateGas(e.hashiAdapter.ContractAddress(), msg.calldata, HASHI_GAS_COST),
 		},
 	)
 	if err != nil {
-		return err
+		return e.retry(props, attempts, err)
 	}
 
-	log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Sent hashi message execution with hash: %s", hash)
+	e.submitted(props, hash, attempts, "hashi message execution")
 	return nil
 }
 
-func (e *EVMExecutor) transfer(props []*proposal.Proposal) error {
+// aggregateMessages stores the batch of hashi messages in a single multicall transaction.
+// If the batch reverts in simulation, messages are sent separately so that a single
+// invalid message doesn't block the rest of the batch.
+func (e *EVMExecutor) aggregateMessages(batch []*hashiMessage, attempts uint64) error {
+	props := make([]*proposal.Proposal, len(batch))
+	calls := make([]contracts.Call3, len(batch))
+	for i, msg := range batch {
+		props[i] = msg.prop
+		calls[i] = contracts.Call3{
+			Target:   *e.hashiAdapter.ContractAddress(),
+			CallData: msg.calldata,
+		}
+	}
+
+	err := e.multicall.SimulateAggregate3(calls)
+	if err != nil {
+		var revertErr *contracts.RevertError
+		if !errors.As(err, &revertErr) {
+			return e.retry(props, attempts, err)
+		}
+
+		log.Warn().Err(err).Uint8("domainID", e.domainID).Msgf("Batch of %d hashi messages reverted, sending messages separately", len(batch))
+		errs := make([]error, len(batch))
+		for i, msg := range batch {
+			errs[i] = e.storeMessage(msg, attempts)
+		}
+		return errors.Join(errs...)
+	}
+
+	calldata, err := e.multicall.Aggregate3Calldata(calls)
+	if err != nil {
+		return e.retry(props, attempts, err)
+	}
+	hash, err := e.multicall.Aggregate3(calls, transactor.TransactOptions{
+		GasLimit: e.gasEstimator.EstimateGas(e.multicall.ContractAddress(), calldata, uint64(len(batch))*HASHI_GAS_COST),
+	})
+	if err != nil {
+		return e.retry(props, attempts, err)
+	}
+
+	e.submitted(props, hash, attempts, fmt.Sprintf("execution of %d hashi messages", len(batch)))
+	return nil
+}
+
+// transfer executes transfer proposals with one executeProposals call per batch of proposals
+// that share the slot and account proof
+func (e *EVMExecutor) transfer(props []*proposal.Proposal, attempts uint64) error {
+	errs := make([]error, 0)
+	for _, group := range proofGroups(props) {
+		errs = append(errs, e.transferGroup(group, attempts))
+	}
+	return errors.Join(errs...)
+}
+
+func (e *EVMExecutor) transferGroup(props []*proposal.Proposal, attempts uint64) error {
 	batches, err := e.proposalBatches(props)
 	if err != nil {
-		return err
+		return e.retry(props, attempts, err)
 	}
 
+	errs := make([]error, 0)
 	batchData := props[0].Data.(message.TransferData)
 	proofBytes, _ := util.ToByteArray(batchData.AccountProof)
 	for _, batch := range batches {
@@ -104,25 +358,198 @@ func (e *EVMExecutor) transfer(props []*proposal.Proposal) error {
 			continue
 		}
 
-		hash, err := e.executor.ExecuteProposals(batch.proposals, proofBytes, batchData.Slot, transactor.TransactOptions{
-			GasLimit: batch.gasLimit,
-		})
+		simulatedBatches, reverted, err := e.simulate(batch, proofBytes, batchData.Slot)
 		if err != nil {
-			log.Err(err).Msgf("Failed executing proposals")
+			log.Err(err).Uint8("domainID", e.domainID).Msgf("Failed simulating proposals execution")
+			errs = append(errs, e.retry(batch.props, attempts, err))
 			continue
 		}
+		for _, revert := range reverted {
+			errs = append(errs, e.retry([]*proposal.Proposal{revert.prop}, attempts, revert.err))
+		}
+		for _, simulatedBatch := range simulatedBatches {
+			errs = append(errs, e.executeBatch(simulatedBatch, proofBytes, batchData.Slot, attempts))
+		}
+	}
+	return errors.Join(errs...)
+}
 
-		log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Sent proposals execution with hash: %s", hash)
+// executeBatch sends the executeProposals transaction of the simulated batch
+func (e *EVMExecutor) executeBatch(batch *Batch, accountProof [][]byte, slot *big.Int, attempts uint64) error {
+	if len(batch.proposals) == 0 {
+		return nil
+	}
+
+	calldata, err := e.executor.ExecuteProposalsCalldata(batch.proposals, accountProof, slot)
+	if err != nil {
+		log.Err(err).Uint8("domainID", e.domainID).Msgf("Failed packing proposals execution")
+		return e.retry(batch.props, attempts, err)
 	}
+	hash, err := e.executor.ExecuteProposals(batch.proposals, accountProof, slot, transactor.TransactOptions{
+		GasLimit: e.gasEstimator.EstimateGas(e.executor.ContractAddress(), calldata, batch.gasLimit),
+	})
+	if err != nil {
+		log.Err(err).Msgf("Failed executing proposals")
+		return e.retry(batch.props, attempts, err)
+	}
+
+	e.submitted(batch.props, hash, attempts, "proposals execution")
 	return nil
 }
 
+// proofGroups splits transfer proposals into groups that share the slot and account proof,
+// preserving the order of proposals
+func proofGroups(props []*proposal.Proposal) [][]*proposal.Proposal {
+	groups := make([][]*proposal.Proposal, 0)
+	groupIndexes := make(map[string]int)
+	for _, prop := range props {
+		data := prop.Data.(message.TransferData)
+		key := fmt.Sprintf("%s:%s", data.Slot, strings.Join(data.AccountProof, ","))
+		i, ok := groupIndexes[key]
+		if !ok {
+			i = len(groups)
+			groupIndexes[key] = i
+			groups = append(groups, make([]*proposal.Proposal, 0))
+		}
+		groups[i] = append(groups[i], prop)
+	}
+	return groups
+}
+
+// submitted logs the sent execution transaction, counts it as submitted and watches its status.
+// Dry-run executions are only simulated, so they are logged as shadow results instead.
+func (e *EVMExecutor) submitted(props []*proposal.Proposal, hash *common.Hash, attempts uint64, execution string) {
+	if e.dryRun {
+		log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Shadow %s of %d proposals succeeded", execution, len(props))
+		return
+	}
+
+	log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Sent %s with hash: %s", execution, hash)
+	e.metrics.TrackExecutionsSubmitted(e.domainID, len(props))
+	e.watch(props, hash, attempts)
+}
+
+func (e *EVMExecutor) watch(props []*proposal.Proposal, hash *common.Hash, attempts uint64) {
+	if e.watcher == nil {
+		return
+	}
+
+	e.watcher.Watch(props, *hash, attempts, e.onWatchFailure)
+}
+
+// Resume watches execution transactions that were pending when the relayer stopped
+func (e *EVMExecutor) Resume() error {
+	if e.watcher == nil {
+		return nil
+	}
+
+	return e.watcher.Resume(e.onWatchFailure)
+}
+
+func (e *EVMExecutor) onWatchFailure(props []*proposal.Proposal, attempts uint64, err error) {
+	_ = e.retry(props, attempts, err)
+}
+
+// pause queues proposals for a later attempt while execution is paused because of the low signer balance.
+// Paused executions don't count as failed attempts.
+func (e *EVMExecutor) pause(props []*proposal.Proposal, attempts uint64) error {
+	err := fmt.Errorf("execution on domain %d paused due to low signer balance", e.domainID)
+	log.Warn().Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Postponing execution of %d proposals", len(props))
+	if e.retryQueue == nil {
+		return err
+	}
+
+	pushErr := e.retryQueue.Push(props, attempts, err)
+	if pushErr != nil {
+		log.Err(pushErr).Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Failed queuing proposals for retry")
+		return err
+	}
+	return nil
+}
+
+// retry pushes the failed proposals to the retry queue. The execution error is returned
+// if proposals can't be retried.
+func (e *EVMExecutor) retry(props []*proposal.Proposal, attempts uint64, err error) error {
+	if e.retryQueue == nil {
+		return err
+	}
+
+	pushErr := e.retryQueue.Push(props, attempts+1, err)
+	if pushErr != nil {
+		log.Err(pushErr).Str("messageID", props[0].MessageID).Uint8("domainID", e.domainID).Msgf("Failed queuing proposals for retry")
+		return err
+	}
+	return nil
+}
+
+// revertedProposal is a proposal whose simulated execution reverted with the decoded revert reason
+type revertedProposal struct {
+	prop *proposal.Proposal
+	err  error
+}
+
+// simulate runs the batch execution with eth_call and, if it reverts, simulates each proposal
+// separately so that reverting proposals are removed from the batch and returned to be retried
+// instead of reverting the whole batch. If the remaining proposals still revert together,
+// they are returned as separate batches.
+func (e *EVMExecutor) simulate(batch *Batch, accountProof [][]byte, slot *big.Int) ([]*Batch, []*revertedProposal, error) {
+	err := e.executor.SimulateExecuteProposals(batch.proposals, accountProof, slot)
+	if err == nil {
+		return []*Batch{batch}, nil, nil
+	}
+	var revertErr *contracts.RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, nil, err
+	}
+	if len(batch.proposals) > 1 {
+		log.Warn().Err(err).Uint8("domainID", e.domainID).Msgf("Batch of %d proposals reverted, simulating proposals separately", len(batch.proposals))
+	}
+
+	simulatedBatch := newBatch()
+	reverted := make([]*revertedProposal, 0)
+	for i, prop := range batch.proposals {
+		if len(batch.proposals) > 1 {
+			err = e.executor.SimulateExecuteProposals([]contracts.ExecutorProposal{prop}, accountProof, slot)
+			if err == nil {
+				simulatedBatch.add(batch.props[i], prop, batch.proposalsGas[i])
+				continue
+			}
+			if !errors.As(err, &revertErr) {
+				return nil, nil, err
+			}
+		}
+
+		log.Warn().Err(err).
+			Str("messageID", batch.props[i].MessageID).
+			Uint8("domainID", e.domainID).
+			Uint8("originDomainID", prop.OriginDomainID).
+			Uint64("depositNonce", prop.DepositNonce).
+			Msgf("Proposal execution reverted, queuing proposal for retry")
+		reverted = append(reverted, &revertedProposal{
+			prop: batch.props[i],
+			err:  err,
+		})
+	}
+	if len(simulatedBatch.proposals) <= 1 {
+		return []*Batch{simulatedBatch}, reverted, nil
+	}
+
+	if len(reverted) > 0 {
+		err = e.executor.SimulateExecuteProposals(simulatedBatch.proposals, accountProof, slot)
+		if err == nil {
+			return []*Batch{simulatedBatch}, reverted, nil
+		}
+		if !errors.As(err, &revertErr) {
+			return nil, nil, err
+		}
+	}
+	log.Warn().Uint8("domainID", e.domainID).Msgf("Batch of %d proposals reverts only when executed together, executing proposals separately", len(simulatedBatch.proposals))
+	return simulatedBatch.split(), reverted, nil
+}
+
 func (e *EVMExecutor) proposalBatches(props []*proposal.Proposal) ([]*Batch, error) {
 	batches := make([]*Batch, 1)
-	currentBatch := &Batch{
-		proposals: make([]contracts.ExecutorProposal, 0),
-		gasLimit:  0,
-	}
+	currentBatch := newBatch()
 	batches[0] = currentBatch
 
 	for _, prop := range props {
@@ -136,25 +563,21 @@ func (e *EVMExecutor) proposalBatches(props []*proposal.Proposal) ([]*Batch, err
 		}
 
 		propGasLimit := e.proposalGas(prop)
-		currentBatch.gasLimit += propGasLimit
-		if currentBatch.gasLimit >= e.transactionMaxGas {
-			currentBatch = &Batch{
-				proposals: make([]contracts.ExecutorProposal, 0),
-				gasLimit:  0,
-			}
+		if currentBatch.gasLimit+propGasLimit >= e.transactionMaxGas {
+			currentBatch = newBatch()
 			batches = append(batches, currentBatch)
 		}
 
 		d := prop.Data.(message.TransferData)
 		proofBytes, _ := util.ToByteArray(d.StorageProof)
-		currentBatch.proposals = append(currentBatch.proposals, contracts.ExecutorProposal{
+		currentBatch.add(prop, contracts.ExecutorProposal{
 			OriginDomainID: prop.Source,
 			SecurityModel:  d.Deposit.SecurityModel,
 			DepositNonce:   d.Deposit.DepositNonce,
 			ResourceID:     d.Deposit.ResourceID,
 			Data:           d.Deposit.Data,
 			StorageProof:   proofBytes,
-		})
+		}, propGasLimit)
 	}
 
 	return batches, nil
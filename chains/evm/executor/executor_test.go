@@ -0,0 +1,413 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/contracts"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/events"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type TransferExecutorTestSuite struct {
+	suite.Suite
+
+	executor         *executor.EVMExecutor
+	mockExecutor     *mock.MockExecutorContract
+	mockHashiAdapter *mock.MockHashiContract
+	mockGasEstimator *mock.MockGasEstimator
+	mockWatcher      *mock.MockExecutionWatcher
+	mockRetryQueue   *mock.MockRetryQueue
+	mockMetrics      *mock.MockExecutorMetrics
+	props            []*proposal.Proposal
+}
+
+func TestRunTransferExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferExecutorTestSuite))
+}
+
+func (s *TransferExecutorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockExecutor = mock.NewMockExecutorContract(ctrl)
+	s.mockHashiAdapter = mock.NewMockHashiContract(ctrl)
+	s.mockGasEstimator = mock.NewMockGasEstimator(ctrl)
+	s.mockWatcher = mock.NewMockExecutionWatcher(ctrl)
+	s.mockRetryQueue = mock.NewMockRetryQueue(ctrl)
+	s.mockMetrics = mock.NewMockExecutorMetrics(ctrl)
+	s.mockMetrics.EXPECT().TrackExecutionsSubmitted(uint8(2), gomock.Any()).AnyTimes()
+	s.executor = executor.NewEVMExecutor(
+		2, s.mockExecutor, s.mockHashiAdapter, nil, s.mockGasEstimator, s.mockWatcher, s.mockRetryQueue, nil, s.mockMetrics, 10000000, false)
+
+	s.props = make([]*proposal.Proposal, 3)
+	for i := range s.props {
+		s.props[i] = proposal.NewProposal(1, 2, message.TransferData{
+			Deposit: &events.Deposit{
+				DestinationDomainID: 2,
+				DepositNonce:        uint64(i + 1),
+			},
+			Slot:         big.NewInt(100),
+			AccountProof: []string{"0x01"},
+			StorageProof: []string{"0x02"},
+			Type:         message.FungibleTransfer,
+		}, "id", message.EVMTransferProposal)
+	}
+	s.mockExecutor.EXPECT().IsProposalExecuted(gomock.Any()).Return(false, nil).AnyTimes()
+	s.mockExecutor.EXPECT().ContractAddress().Return(&common.Address{}).AnyTimes()
+	s.mockExecutor.EXPECT().ExecuteProposalsCalldata(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte{1}, nil).AnyTimes()
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_SimulationSuccessful() {
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(3*executor.TRANSFER_GAS_COST)).Return(uint64(500000))
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), transactor.TransactOptions{
+		GasLimit: 500000,
+	}).DoAndReturn(
+		func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int, _ transactor.TransactOptions) (*common.Hash, error) {
+			s.Equal(len(props), 3)
+			return &common.Hash{1}, nil
+		})
+	s.mockWatcher.EXPECT().Watch(s.props, common.Hash{1}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_DryRun() {
+	ctrl := gomock.NewController(s.T())
+	e := executor.NewEVMExecutor(
+		2, s.mockExecutor, s.mockHashiAdapter, nil, s.mockGasEstimator, nil, nil, nil, mock.NewMockExecutorMetrics(ctrl), 10000000, true)
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(500000))
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), gomock.Any()).Return(&common.Hash{}, nil)
+
+	err := e.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_SimulationFailed() {
+	simulationErr := fmt.Errorf("error")
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(simulationErr)
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(1), simulationErr).Return(nil)
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_RetryFailed() {
+	simulationErr := fmt.Errorf("error")
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(simulationErr)
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(1), simulationErr).Return(fmt.Errorf("store error"))
+
+	err := s.executor.Execute(s.props)
+
+	s.ErrorIs(err, simulationErr)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_PausedOnLowBalance() {
+	mockBalanceMonitor := mock.NewMockBalanceMonitor(gomock.NewController(s.T()))
+	mockBalanceMonitor.EXPECT().Paused().Return(true)
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(2), gomock.Any()).Return(nil)
+	e := executor.NewEVMExecutor(
+		2, s.mockExecutor, s.mockHashiAdapter, nil, s.mockGasEstimator, s.mockWatcher, s.mockRetryQueue, mockBalanceMonitor, s.mockMetrics, 10000000, false)
+
+	e.Retry(s.props, 2)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_ExecutionFailed() {
+	executionErr := fmt.Errorf("error")
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(500000))
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), gomock.Any()).Return(nil, executionErr)
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(3), executionErr).Return(nil)
+
+	s.executor.Retry(s.props, 2)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_FailedTransactionRetried() {
+	txErr := fmt.Errorf("transaction failed")
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(500000))
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockWatcher.EXPECT().Watch(s.props, common.Hash{1}, uint64(1), gomock.Any()).Do(
+		func(props []*proposal.Proposal, _ common.Hash, attempts uint64, onFailure func(props []*proposal.Proposal, attempts uint64, err error)) {
+			onFailure(props, attempts, txErr)
+		})
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(2), txErr).Return(nil)
+
+	s.executor.Retry(s.props, 1)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_RevertedProposalsRemoved() {
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).DoAndReturn(
+		func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int) error {
+			for _, prop := range props {
+				if prop.DepositNonce == 2 {
+					return &contracts.RevertError{Reason: "TransferHashDoesNotMatchSlotValue"}
+				}
+			}
+			return nil
+		}).Times(5)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(2*executor.TRANSFER_GAS_COST)).Return(uint64(400000))
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), gomock.Any()).DoAndReturn(
+		func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int, _ transactor.TransactOptions) (*common.Hash, error) {
+			s.Equal(len(props), 2)
+			s.Equal(props[0].DepositNonce, uint64(1))
+			s.Equal(props[1].DepositNonce, uint64(3))
+			return &common.Hash{1}, nil
+		})
+	s.mockWatcher.EXPECT().Watch([]*proposal.Proposal{s.props[0], s.props[2]}, common.Hash{1}, gomock.Any(), gomock.Any())
+	s.mockRetryQueue.EXPECT().Push(
+		[]*proposal.Proposal{s.props[1]}, uint64(1), &contracts.RevertError{Reason: "TransferHashDoesNotMatchSlotValue"}).Return(nil)
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_BatchRevertsOnlyTogether() {
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Len(3), gomock.Any(), big.NewInt(100)).Return(
+		&contracts.RevertError{Reason: "OutOfFunds()"})
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Len(1), gomock.Any(), big.NewInt(100)).Return(nil).Times(3)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(executor.TRANSFER_GAS_COST)).Return(uint64(400000)).Times(3)
+	for i, prop := range s.props {
+		nonce := uint64(i + 1)
+		hash := common.Hash{byte(i + 1)}
+		s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100), gomock.Any()).DoAndReturn(
+			func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int, _ transactor.TransactOptions) (*common.Hash, error) {
+				s.Equal(len(props), 1)
+				s.Equal(props[0].DepositNonce, nonce)
+				return &hash, nil
+			})
+		s.mockWatcher.EXPECT().Watch([]*proposal.Proposal{prop}, hash, gomock.Any(), gomock.Any())
+	}
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_AllProposalsReverted() {
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), gomock.Any(), big.NewInt(100)).Return(
+		&contracts.RevertError{Reason: "BridgeIsPaused()"}).Times(4)
+	for _, prop := range s.props {
+		s.mockRetryQueue.EXPECT().Push(
+			[]*proposal.Proposal{prop}, uint64(1), &contracts.RevertError{Reason: "BridgeIsPaused()"}).Return(nil)
+	}
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_SingleProposalReverted() {
+	revertErr := &contracts.RevertError{Reason: "BridgeIsPaused()"}
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Len(1), gomock.Any(), big.NewInt(100)).Return(revertErr)
+	s.mockRetryQueue.EXPECT().Push(s.props[:1], uint64(3), revertErr).Return(nil)
+
+	s.executor.Retry(s.props[:1], 2)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_RevertedProposalRetryFailed() {
+	revertErr := &contracts.RevertError{Reason: "BridgeIsPaused()"}
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Len(1), gomock.Any(), big.NewInt(100)).Return(revertErr)
+	s.mockRetryQueue.EXPECT().Push(s.props[:1], uint64(1), revertErr).Return(fmt.Errorf("store error"))
+
+	err := s.executor.Execute(s.props[:1])
+
+	s.ErrorIs(err, revertErr)
+}
+
+func (s *TransferExecutorTestSuite) Test_Execute_ProposalsGroupedByAccountProof() {
+	slotData := s.props[1].Data.(message.TransferData)
+	slotData.Slot = big.NewInt(200)
+	slotData.AccountProof = []string{"0x03"}
+	s.props[1].Data = slotData
+
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), [][]byte{{1}}, big.NewInt(100)).Return(nil)
+	s.mockExecutor.EXPECT().SimulateExecuteProposals(gomock.Any(), [][]byte{{3}}, big.NewInt(200)).Return(nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(500000)).Times(2)
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), [][]byte{{1}}, big.NewInt(100), gomock.Any()).DoAndReturn(
+		func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int, _ transactor.TransactOptions) (*common.Hash, error) {
+			s.Equal(len(props), 2)
+			s.Equal(props[0].DepositNonce, uint64(1))
+			s.Equal(props[1].DepositNonce, uint64(3))
+			return &common.Hash{1}, nil
+		})
+	s.mockExecutor.EXPECT().ExecuteProposals(gomock.Any(), [][]byte{{3}}, big.NewInt(200), gomock.Any()).DoAndReturn(
+		func(props []contracts.ExecutorProposal, _ [][]byte, _ *big.Int, _ transactor.TransactOptions) (*common.Hash, error) {
+			s.Equal(len(props), 1)
+			s.Equal(props[0].DepositNonce, uint64(2))
+			return &common.Hash{2}, nil
+		})
+	s.mockWatcher.EXPECT().Watch([]*proposal.Proposal{s.props[0], s.props[2]}, common.Hash{1}, gomock.Any(), gomock.Any())
+	s.mockWatcher.EXPECT().Watch([]*proposal.Proposal{s.props[1]}, common.Hash{2}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+type HashiExecutorTestSuite struct {
+	suite.Suite
+
+	executor         *executor.EVMExecutor
+	mockExecutor     *mock.MockExecutorContract
+	mockHashiAdapter *mock.MockHashiContract
+	mockMulticall    *mock.MockMulticallContract
+	mockGasEstimator *mock.MockGasEstimator
+	mockWatcher      *mock.MockExecutionWatcher
+	mockRetryQueue   *mock.MockRetryQueue
+	mockMetrics      *mock.MockExecutorMetrics
+	props            []*proposal.Proposal
+}
+
+func TestRunHashiExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(HashiExecutorTestSuite))
+}
+
+func (s *HashiExecutorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockExecutor = mock.NewMockExecutorContract(ctrl)
+	s.mockHashiAdapter = mock.NewMockHashiContract(ctrl)
+	s.mockMulticall = mock.NewMockMulticallContract(ctrl)
+	s.mockGasEstimator = mock.NewMockGasEstimator(ctrl)
+	s.mockWatcher = mock.NewMockExecutionWatcher(ctrl)
+	s.mockRetryQueue = mock.NewMockRetryQueue(ctrl)
+	s.mockMetrics = mock.NewMockExecutorMetrics(ctrl)
+	s.mockMetrics.EXPECT().TrackExecutionsSubmitted(uint8(2), gomock.Any()).AnyTimes()
+	s.executor = executor.NewEVMExecutor(
+		2, s.mockExecutor, s.mockHashiAdapter, s.mockMulticall, s.mockGasEstimator, s.mockWatcher, s.mockRetryQueue, nil, s.mockMetrics, 10000000, false)
+
+	s.props = []*proposal.Proposal{
+		proposal.NewProposal(1, 2, message.HashiData{
+			SrcSlot:             big.NewInt(100),
+			TxSlot:              big.NewInt(90),
+			LogIndex:            big.NewInt(1),
+			DispatchedMessageID: big.NewInt(7),
+		}, "message", message.HashiProposal),
+	}
+	s.mockHashiAdapter.EXPECT().ContractAddress().Return(&common.Address{}).AnyTimes()
+	s.mockMulticall.EXPECT().ContractAddress().Return(&common.Address{1}).AnyTimes()
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_MessageHashFetchFails() {
+	fetchErr := fmt.Errorf("error")
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(7)).Return([32]byte{}, fetchErr)
+	s.mockRetryQueue.EXPECT().Push(s.props, uint64(1), fetchErr).Return(nil)
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_MessageAlreadyStored() {
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(7)).Return([32]byte{1}, nil)
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_MessageStored() {
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(7)).Return([32]byte{}, nil)
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessageCalldata(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(1)).Return([]byte{1}, nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(executor.HASHI_GAS_COST)).Return(uint64(1000000))
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessage(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(1), transactor.TransactOptions{
+			GasLimit: 1000000,
+		}).Return(&common.Hash{1}, nil)
+	s.mockWatcher.EXPECT().Watch(s.props, common.Hash{1}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(s.props)
+
+	s.Nil(err)
+}
+
+func (s *HashiExecutorTestSuite) hashiProps(count int) []*proposal.Proposal {
+	props := make([]*proposal.Proposal, count)
+	for i := range props {
+		props[i] = proposal.NewProposal(1, 2, message.HashiData{
+			SrcSlot:             big.NewInt(100),
+			TxSlot:              big.NewInt(90),
+			LogIndex:            big.NewInt(int64(i)),
+			DispatchedMessageID: big.NewInt(int64(i)),
+		}, fmt.Sprintf("message%d", i), message.HashiProposal)
+	}
+	return props
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_MessagesBatched() {
+	props := s.hashiProps(4)
+	s.mockHashiAdapter.EXPECT().MessageHash(gomock.Any()).Return([32]byte{}, nil).Times(4)
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessageCalldata(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte{1}, nil).Times(4)
+	s.mockMulticall.EXPECT().SimulateAggregate3(gomock.Len(3)).Return(nil)
+	s.mockMulticall.EXPECT().Aggregate3Calldata(gomock.Len(3)).Return([]byte{2}, nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{1}, []byte{2}, uint64(3*executor.HASHI_GAS_COST)).Return(uint64(5000000))
+	s.mockMulticall.EXPECT().Aggregate3(gomock.Len(3), transactor.TransactOptions{
+		GasLimit: 5000000,
+	}).Return(&common.Hash{1}, nil)
+	s.mockWatcher.EXPECT().Watch(props[:3], common.Hash{1}, gomock.Any(), gomock.Any())
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(executor.HASHI_GAS_COST)).Return(uint64(1000000))
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessage(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(3), gomock.Any()).Return(&common.Hash{2}, nil)
+	s.mockWatcher.EXPECT().Watch(props[3:], common.Hash{2}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(props)
+
+	s.Nil(err)
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_BatchSimulationReverts() {
+	props := s.hashiProps(2)
+	s.mockHashiAdapter.EXPECT().MessageHash(gomock.Any()).Return([32]byte{}, nil).Times(2)
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessageCalldata(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte{1}, nil).Times(2)
+	s.mockMulticall.EXPECT().SimulateAggregate3(gomock.Len(2)).Return(&contracts.RevertError{Reason: "Multicall3: call failed"})
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(executor.HASHI_GAS_COST)).Return(uint64(1000000)).Times(2)
+	executionErr := fmt.Errorf("error")
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessage(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(0), gomock.Any()).Return(nil, executionErr)
+	s.mockRetryQueue.EXPECT().Push(props[:1], uint64(1), executionErr).Return(nil)
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessage(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(1), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockWatcher.EXPECT().Watch(props[1:], common.Hash{1}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(props)
+
+	s.Nil(err)
+}
+
+func (s *HashiExecutorTestSuite) Test_Execute_StoredMessagesSkipped() {
+	props := s.hashiProps(3)
+	fetchErr := fmt.Errorf("error")
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(0)).Return([32]byte{1}, nil)
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(1)).Return([32]byte{}, fetchErr)
+	s.mockRetryQueue.EXPECT().Push(props[1:2], uint64(1), fetchErr).Return(nil)
+	s.mockHashiAdapter.EXPECT().MessageHash(big.NewInt(2)).Return([32]byte{}, nil)
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessageCalldata(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(2)).Return([]byte{1}, nil)
+	s.mockGasEstimator.EXPECT().EstimateGas(&common.Address{}, []byte{1}, uint64(executor.HASHI_GAS_COST)).Return(uint64(1000000))
+	s.mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessage(
+		uint64(100), uint64(90), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), big.NewInt(2), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockWatcher.EXPECT().Watch(props[2:], common.Hash{1}, gomock.Any(), gomock.Any())
+
+	err := s.executor.Execute(props)
+
+	s.Nil(err)
+}
@@ -0,0 +1,59 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type GasEstimatorClient interface {
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	From() common.Address
+}
+
+// EVMGasEstimator estimates transaction gas limits with eth_estimateGas
+type EVMGasEstimator struct {
+	client      GasEstimatorClient
+	gasMargin   uint64
+	maxGasLimit uint64
+}
+
+// NewEVMGasEstimator creates a gas estimator that increases estimates by gasMargin percent
+// and caps them at maxGasLimit
+func NewEVMGasEstimator(client GasEstimatorClient, gasMargin uint64, maxGasLimit uint64) *EVMGasEstimator {
+	return &EVMGasEstimator{
+		client:      client,
+		gasMargin:   gasMargin,
+		maxGasLimit: maxGasLimit,
+	}
+}
+
+// EstimateGas returns the gas limit of the transaction with the safety margin applied.
+// The fallback gas limit is returned if the estimation fails.
+func (e *EVMGasEstimator) EstimateGas(to *common.Address, calldata []byte, fallbackGas uint64) uint64 {
+	estimatedGas, err := e.client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From: e.client.From(),
+		To:   to,
+		Data: calldata,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("to", to.Hex()).Msgf("Failed estimating gas, using fallback gas limit %d", fallbackGas)
+		return e.cap(fallbackGas)
+	}
+
+	return e.cap(estimatedGas + estimatedGas*e.gasMargin/100)
+}
+
+func (e *EVMGasEstimator) cap(gas uint64) uint64 {
+	if gas > e.maxGasLimit {
+		log.Warn().Msgf("Gas limit %d exceeds the maximum gas limit %d", gas, e.maxGasLimit)
+		return e.maxGasLimit
+	}
+
+	return gas
+}
@@ -0,0 +1,75 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type GasEstimatorTestSuite struct {
+	suite.Suite
+
+	gasEstimator *executor.EVMGasEstimator
+	mockClient   *mock.MockGasEstimatorClient
+	from         common.Address
+	to           common.Address
+}
+
+func TestRunGasEstimatorTestSuite(t *testing.T) {
+	suite.Run(t, new(GasEstimatorTestSuite))
+}
+
+func (s *GasEstimatorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock.NewMockGasEstimatorClient(ctrl)
+	s.gasEstimator = executor.NewEVMGasEstimator(s.mockClient, 20, 5000000)
+	s.from = common.HexToAddress("0x1")
+	s.to = common.HexToAddress("0x2")
+
+	s.mockClient.EXPECT().From().Return(s.from).AnyTimes()
+}
+
+func (s *GasEstimatorTestSuite) Test_EstimateGas_EstimationFails() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), fmt.Errorf("error"))
+
+	gas := s.gasEstimator.EstimateGas(&s.to, []byte{1}, executor.HASHI_GAS_COST)
+
+	s.Equal(gas, uint64(executor.HASHI_GAS_COST))
+}
+
+func (s *GasEstimatorTestSuite) Test_EstimateGas_FallbackCapped() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), fmt.Errorf("error"))
+
+	gas := s.gasEstimator.EstimateGas(&s.to, []byte{1}, 10000000)
+
+	s.Equal(gas, uint64(5000000))
+}
+
+func (s *GasEstimatorTestSuite) Test_EstimateGas_MarginApplied() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), ethereum.CallMsg{
+		From: s.from,
+		To:   &s.to,
+		Data: []byte{1},
+	}).Return(uint64(1000000), nil)
+
+	gas := s.gasEstimator.EstimateGas(&s.to, []byte{1}, executor.HASHI_GAS_COST)
+
+	s.Equal(gas, uint64(1200000))
+}
+
+func (s *GasEstimatorTestSuite) Test_EstimateGas_EstimateCapped() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(4500000), nil)
+
+	gas := s.gasEstimator.EstimateGas(&s.to, []byte{1}, executor.HASHI_GAS_COST)
+
+	s.Equal(gas, uint64(5000000))
+}
@@ -0,0 +1,193 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+)
+
+type RetryStorer interface {
+	StoreRetryQueue(domainID uint8, entries []*store.RetryEntry) error
+	RetryQueue(domainID uint8) ([]*store.RetryEntry, error)
+	StoreDeadLetters(domainID uint8, entries []*store.RetryEntry) error
+	DeadLetters(domainID uint8) ([]*store.RetryEntry, error)
+}
+
+// EVMRetryQueue is a persistent queue of failed executions retried with exponential backoff.
+// Executions that reach the maximum attempts are moved to the dead-letter list.
+type EVMRetryQueue struct {
+	domainID    uint8
+	retryStore  RetryStorer
+	maxAttempts uint64
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	lock        sync.Mutex
+}
+
+func NewEVMRetryQueue(
+	domainID uint8,
+	retryStore RetryStorer,
+	maxAttempts uint64,
+	backoff time.Duration,
+	maxBackoff time.Duration,
+) *EVMRetryQueue {
+	return &EVMRetryQueue{
+		domainID:    domainID,
+		retryStore:  retryStore,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+	}
+}
+
+// Push schedules the proposals that failed execution after the given number of attempts
+func (q *EVMRetryQueue) Push(props []*proposal.Proposal, attempts uint64, executionErr error) error {
+	encodedProps, err := message.MarshalProposals(props)
+	if err != nil {
+		return err
+	}
+	entry := &store.RetryEntry{
+		Proposals: encodedProps,
+		Attempts:  attempts,
+		Error:     executionErr.Error(),
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if attempts >= q.maxAttempts {
+		deadLetters, err := q.retryStore.DeadLetters(q.domainID)
+		if err != nil {
+			return err
+		}
+
+		log.Error().Str("messageID", props[0].MessageID).Uint8("domainID", q.domainID).Msgf(
+			"Execution of %d proposals failed after %d attempts, moving to dead letters", len(props), attempts)
+		return q.retryStore.StoreDeadLetters(q.domainID, append(deadLetters, entry))
+	}
+
+	queue, err := q.retryStore.RetryQueue(q.domainID)
+	if err != nil {
+		return err
+	}
+
+	entry.NextAttempt = time.Now().Add(q.delay(attempts))
+	log.Warn().Str("messageID", props[0].MessageID).Uint8("domainID", q.domainID).Msgf(
+		"Retrying execution of %d proposals at %s", len(props), entry.NextAttempt)
+	return q.retryStore.StoreRetryQueue(q.domainID, append(queue, entry))
+}
+
+// Run periodically passes proposals whose backoff expired to retry until the context is cancelled.
+// Entries are removed from the queue only after retry returns, so that due entries are not lost
+// if the relayer stops before they are resubmitted or queued again.
+func (q *EVMRetryQueue) Run(ctx context.Context, interval time.Duration, retry func(props []*proposal.Proposal, attempts uint64)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			entries, err := q.due(time.Now())
+			if err != nil {
+				log.Err(err).Uint8("domainID", q.domainID).Msgf("Failed fetching retry queue")
+				continue
+			}
+
+			for _, entry := range entries {
+				props, err := message.UnmarshalProposals(entry.Proposals)
+				if err != nil {
+					log.Err(err).Uint8("domainID", q.domainID).Msgf("Failed decoding retried proposals, moving to dead letters")
+					err = q.moveToDeadLetters(entry)
+					if err != nil {
+						log.Err(err).Uint8("domainID", q.domainID).Msgf("Failed moving retry entry to dead letters")
+					}
+					continue
+				}
+
+				retry(props, entry.Attempts)
+				err = q.remove(entry)
+				if err != nil {
+					log.Err(err).Str("messageID", props[0].MessageID).Uint8("domainID", q.domainID).Msgf("Failed removing retried proposals from the retry queue")
+				}
+			}
+		}
+	}
+}
+
+// due returns entries that are due for another attempt
+func (q *EVMRetryQueue) due(now time.Time) ([]*store.RetryEntry, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	queue, err := q.retryStore.RetryQueue(q.domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]*store.RetryEntry, 0)
+	for _, entry := range queue {
+		if !entry.NextAttempt.After(now) {
+			due = append(due, entry)
+		}
+	}
+	return due, nil
+}
+
+// remove removes the entry from the stored queue
+func (q *EVMRetryQueue) remove(entry *store.RetryEntry) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	queue, err := q.retryStore.RetryQueue(q.domainID)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*store.RetryEntry, 0, len(queue))
+	for _, queued := range queue {
+		if !sameEntry(queued, entry) {
+			remaining = append(remaining, queued)
+		}
+	}
+	return q.retryStore.StoreRetryQueue(q.domainID, remaining)
+}
+
+func (q *EVMRetryQueue) moveToDeadLetters(entry *store.RetryEntry) error {
+	q.lock.Lock()
+	deadLetters, err := q.retryStore.DeadLetters(q.domainID)
+	if err == nil {
+		err = q.retryStore.StoreDeadLetters(q.domainID, append(deadLetters, entry))
+	}
+	q.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return q.remove(entry)
+}
+
+func sameEntry(a *store.RetryEntry, b *store.RetryEntry) bool {
+	return a.Attempts == b.Attempts && a.NextAttempt.Equal(b.NextAttempt) && bytes.Equal(a.Proposals, b.Proposals)
+}
+
+func (q *EVMRetryQueue) delay(attempts uint64) time.Duration {
+	delay := q.backoff
+	for i := uint64(1); i < attempts && delay < q.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > q.maxBackoff {
+		return q.maxBackoff
+	}
+
+	return delay
+}
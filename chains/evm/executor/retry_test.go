@@ -0,0 +1,150 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"go.uber.org/mock/gomock"
+)
+
+type RetryQueueTestSuite struct {
+	suite.Suite
+
+	retryQueue     *executor.EVMRetryQueue
+	mockRetryStore *mock.MockRetryStorer
+	props          []*proposal.Proposal
+}
+
+func TestRunRetryQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryQueueTestSuite))
+}
+
+func (s *RetryQueueTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockRetryStore = mock.NewMockRetryStorer(ctrl)
+	s.retryQueue = executor.NewEVMRetryQueue(2, s.mockRetryStore, 3, time.Minute, time.Minute*3)
+	s.props = []*proposal.Proposal{
+		proposal.NewProposal(1, 2, message.HashiData{}, "message", message.HashiProposal),
+	}
+}
+
+func (s *RetryQueueTestSuite) Test_Push_FailedFetch() {
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return(nil, fmt.Errorf("error"))
+
+	err := s.retryQueue.Push(s.props, 1, fmt.Errorf("execution error"))
+
+	s.NotNil(err)
+}
+
+func (s *RetryQueueTestSuite) Test_Push_BackoffIncreased() {
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{}, nil).Times(3)
+	delays := make([]time.Duration, 0)
+	s.mockRetryStore.EXPECT().StoreRetryQueue(uint8(2), gomock.Any()).DoAndReturn(func(_ uint8, entries []*store.RetryEntry) error {
+		s.Equal(len(entries), 1)
+		s.Equal(entries[0].Error, "execution error")
+		delays = append(delays, time.Until(entries[0].NextAttempt).Round(time.Minute))
+		return nil
+	}).Times(3)
+
+	for attempts := uint64(0); attempts < 3; attempts++ {
+		err := s.retryQueue.Push(s.props, attempts, fmt.Errorf("execution error"))
+		s.Nil(err)
+	}
+
+	s.Equal(delays, []time.Duration{time.Minute, time.Minute, time.Minute * 2})
+}
+
+func (s *RetryQueueTestSuite) Test_Push_BackoffCapped() {
+	s.retryQueue = executor.NewEVMRetryQueue(2, s.mockRetryStore, 10, time.Minute, time.Minute*3)
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{}, nil)
+	s.mockRetryStore.EXPECT().StoreRetryQueue(uint8(2), gomock.Any()).DoAndReturn(func(_ uint8, entries []*store.RetryEntry) error {
+		s.Equal(time.Until(entries[0].NextAttempt).Round(time.Minute), time.Minute*3)
+		return nil
+	})
+
+	err := s.retryQueue.Push(s.props, 5, fmt.Errorf("execution error"))
+
+	s.Nil(err)
+}
+
+func (s *RetryQueueTestSuite) Test_Push_MaxAttemptsReached() {
+	s.mockRetryStore.EXPECT().DeadLetters(uint8(2)).Return([]*store.RetryEntry{{Attempts: 3}}, nil)
+	s.mockRetryStore.EXPECT().StoreDeadLetters(uint8(2), gomock.Any()).DoAndReturn(func(_ uint8, entries []*store.RetryEntry) error {
+		s.Equal(len(entries), 2)
+		s.Equal(entries[1].Attempts, uint64(3))
+		return nil
+	})
+
+	err := s.retryQueue.Push(s.props, 3, fmt.Errorf("execution error"))
+
+	s.Nil(err)
+}
+
+func (s *RetryQueueTestSuite) Test_Run_DueEntriesRetried() {
+	encodedProps, _ := message.MarshalProposals(s.props)
+	pendingEntry := &store.RetryEntry{
+		Proposals:   encodedProps,
+		Attempts:    1,
+		NextAttempt: time.Now().Add(time.Hour),
+	}
+	dueEntry := &store.RetryEntry{
+		Proposals:   encodedProps,
+		Attempts:    2,
+		NextAttempt: time.Now(),
+	}
+	retried := make(chan uint64, 1)
+	removed := make(chan bool, 1)
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{pendingEntry, dueEntry}, nil).Times(2)
+	s.mockRetryStore.EXPECT().StoreRetryQueue(uint8(2), []*store.RetryEntry{pendingEntry}).DoAndReturn(func(_ uint8, _ []*store.RetryEntry) error {
+		s.Equal(len(retried), 1)
+		removed <- true
+		return nil
+	})
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{pendingEntry}, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.retryQueue.Run(ctx, time.Millisecond, func(props []*proposal.Proposal, attempts uint64) {
+		s.Equal(props, s.props)
+		retried <- attempts
+	})
+
+	<-removed
+	s.Equal(<-retried, uint64(2))
+}
+
+func (s *RetryQueueTestSuite) Test_Run_InvalidEntryMovedToDeadLetters() {
+	invalidEntry := &store.RetryEntry{
+		Proposals:   []byte("invalid"),
+		Attempts:    1,
+		NextAttempt: time.Now(),
+	}
+	removed := make(chan bool, 1)
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{invalidEntry}, nil).Times(2)
+	s.mockRetryStore.EXPECT().DeadLetters(uint8(2)).Return([]*store.RetryEntry{}, nil)
+	s.mockRetryStore.EXPECT().StoreDeadLetters(uint8(2), []*store.RetryEntry{invalidEntry}).Return(nil)
+	s.mockRetryStore.EXPECT().StoreRetryQueue(uint8(2), []*store.RetryEntry{}).DoAndReturn(func(_ uint8, _ []*store.RetryEntry) error {
+		removed <- true
+		return nil
+	})
+	s.mockRetryStore.EXPECT().RetryQueue(uint8(2)).Return([]*store.RetryEntry{}, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.retryQueue.Run(ctx, time.Millisecond, func(props []*proposal.Proposal, attempts uint64) {
+		s.Fail("invalid entry retried")
+	})
+
+	<-removed
+}
@@ -0,0 +1,66 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/client"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+)
+
+type ShadowClient interface {
+	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	From() common.Address
+}
+
+// ShadowTransactor simulates transactions with eth_call and eth_estimateGas instead of
+// broadcasting them, so the executor can run in shadow of a production relayer.
+type ShadowTransactor struct {
+	domainID uint8
+	client   ShadowClient
+}
+
+func NewShadowTransactor(domainID uint8, client ShadowClient) *ShadowTransactor {
+	return &ShadowTransactor{
+		domainID: domainID,
+		client:   client,
+	}
+}
+
+// Transact simulates the transaction against the latest block and returns an empty hash
+// as the transaction is never sent. Reverted simulations are returned as errors.
+func (t *ShadowTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	msg := ethereum.CallMsg{
+		From:  t.client.From(),
+		To:    to,
+		Data:  data,
+		Gas:   opts.GasLimit,
+		Value: opts.Value,
+	}
+	logger := log.With().Uint8("domainID", t.domainID).Str("to", to.Hex()).Logger()
+	logger.Debug().Str("calldata", hexutil.Encode(data)).Msg("Simulating shadow transaction")
+
+	_, err := t.client.CallContract(context.Background(), client.ToCallArg(msg), nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Shadow transaction reverted")
+		return nil, err
+	}
+
+	msg.Gas = 0
+	estimatedGas, err := t.client.EstimateGas(context.Background(), msg)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed estimating gas of shadow transaction")
+		return nil, err
+	}
+
+	logger.Info().Uint64("gasLimit", opts.GasLimit).Uint64("estimatedGas", estimatedGas).Msg("Shadow transaction succeeded")
+	return &common.Hash{}, nil
+}
@@ -0,0 +1,82 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type ShadowTransactorTestSuite struct {
+	suite.Suite
+
+	transactor   *executor.ShadowTransactor
+	mockClient   *mock.MockShadowClient
+	from         common.Address
+	to           common.Address
+	calldata     []byte
+	transactOpts transactor.TransactOptions
+}
+
+func TestRunShadowTransactorTestSuite(t *testing.T) {
+	suite.Run(t, new(ShadowTransactorTestSuite))
+}
+
+func (s *ShadowTransactorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock.NewMockShadowClient(ctrl)
+	s.transactor = executor.NewShadowTransactor(1, s.mockClient)
+	s.from = common.HexToAddress("0x1")
+	s.to = common.HexToAddress("0x2")
+	s.calldata = []byte{1, 2, 3}
+	s.transactOpts = transactor.TransactOptions{GasLimit: executor.HASHI_GAS_COST}
+
+	s.mockClient.EXPECT().From().Return(s.from).AnyTimes()
+}
+
+func (s *ShadowTransactorTestSuite) Test_Transact_CallReverts() {
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(nil, fmt.Errorf("execution reverted"))
+
+	hash, err := s.transactor.Transact(&s.to, s.calldata, s.transactOpts)
+
+	s.NotNil(err)
+	s.Nil(hash)
+}
+
+func (s *ShadowTransactorTestSuite) Test_Transact_EstimateGasFails() {
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return([]byte{}, nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), fmt.Errorf("error"))
+
+	hash, err := s.transactor.Transact(&s.to, s.calldata, s.transactOpts)
+
+	s.NotNil(err)
+	s.Nil(hash)
+}
+
+func (s *ShadowTransactorTestSuite) Test_Transact_Successful() {
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+		func(_ interface{}, callArgs map[string]interface{}, _ interface{}) ([]byte, error) {
+			s.Equal(s.from, callArgs["from"])
+			s.Equal(&s.to, callArgs["to"])
+			return []byte{}, nil
+		})
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), ethereum.CallMsg{
+		From: s.from,
+		To:   &s.to,
+		Data: s.calldata,
+	}).Return(uint64(200000), nil)
+
+	hash, err := s.transactor.Transact(&s.to, s.calldata, s.transactOpts)
+
+	s.Nil(err)
+	s.Equal(hash, &common.Hash{})
+}
@@ -0,0 +1,335 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/client"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/contracts"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+)
+
+const RECEIPT_TIMEOUT = time.Minute * 15
+
+type WatcherClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	From() common.Address
+}
+
+type ExecutionStorer interface {
+	StoreExecution(execution *store.Execution) error
+	Execution(messageID string) (*store.Execution, error)
+	StorePendingTransaction(domainID uint8, tx *store.PendingTransaction) error
+	RemovePendingTransaction(domainID uint8, txHash common.Hash) error
+	PendingTransactions(domainID uint8) ([]*store.PendingTransaction, error)
+}
+
+type WatcherMetrics interface {
+	TrackExecutionsFailed(domainID uint8, count int)
+	TrackGasSpent(domainID uint8, gasUsed uint64)
+}
+
+// EVMExecutionWatcher records the status of execution transactions per message
+type EVMExecutionWatcher struct {
+	domainID       uint8
+	client         WatcherClient
+	executionStore ExecutionStorer
+	metrics        WatcherMetrics
+	pollInterval   time.Duration
+	receiptTimeout time.Duration
+}
+
+func NewEVMExecutionWatcher(
+	domainID uint8,
+	client WatcherClient,
+	executionStore ExecutionStorer,
+	metrics WatcherMetrics,
+	pollInterval time.Duration,
+) *EVMExecutionWatcher {
+	return &EVMExecutionWatcher{
+		domainID:       domainID,
+		client:         client,
+		executionStore: executionStore,
+		metrics:        metrics,
+		pollInterval:   pollInterval,
+		receiptTimeout: RECEIPT_TIMEOUT,
+	}
+}
+
+// Watch stores pending executions of the proposals sent in the transaction and waits for the
+// transaction receipt in the background. The transaction is tracked by the sender nonce so that
+// replacements resent by the transactor under a different hash are found. Proposals of failed
+// or dropped transactions are passed to onFailure.
+func (w *EVMExecutionWatcher) Watch(
+	props []*proposal.Proposal,
+	txHash common.Hash,
+	attempts uint64,
+	onFailure func(props []*proposal.Proposal, attempts uint64, err error),
+) {
+	executions := make([]*store.Execution, len(props))
+	for i, prop := range props {
+		var executionAttempts uint64
+		execution, err := w.executionStore.Execution(prop.MessageID)
+		if err != nil {
+			log.Warn().Err(err).Str("messageID", prop.MessageID).Msgf("Failed fetching previous execution")
+		} else if execution != nil && execution.Status != store.SuccessfulExecution {
+			executionAttempts = execution.Attempts
+		}
+
+		executions[i] = &store.Execution{
+			MessageID: prop.MessageID,
+			DomainID:  w.domainID,
+			TxHash:    txHash,
+			Status:    store.PendingExecution,
+			Attempts:  executionAttempts + 1,
+		}
+	}
+	w.storeExecutions(executions)
+
+	pendingTx := w.pendingTransaction(props, txHash, attempts)
+	err := w.executionStore.StorePendingTransaction(w.domainID, pendingTx)
+	if err != nil {
+		log.Err(err).Str("messageID", props[0].MessageID).Uint8("domainID", w.domainID).Msgf("Failed storing pending transaction %s", txHash)
+	}
+
+	go w.wait(pendingTx, props, executions, onFailure)
+}
+
+// Resume watches transactions that were pending when the relayer stopped
+func (w *EVMExecutionWatcher) Resume(onFailure func(props []*proposal.Proposal, attempts uint64, err error)) error {
+	pendingTxs, err := w.executionStore.PendingTransactions(w.domainID)
+	if err != nil {
+		return err
+	}
+
+	for _, pendingTx := range pendingTxs {
+		props, err := message.UnmarshalProposals(pendingTx.Proposals)
+		if err != nil || len(props) == 0 {
+			log.Warn().Err(err).Uint8("domainID", w.domainID).Msgf("Failed decoding proposals of pending transaction %s", pendingTx.TxHash)
+			w.removePendingTransaction(pendingTx.TxHash)
+			continue
+		}
+
+		executions := make([]*store.Execution, len(props))
+		for i, prop := range props {
+			execution, err := w.executionStore.Execution(prop.MessageID)
+			if err != nil || execution == nil {
+				execution = &store.Execution{
+					MessageID: prop.MessageID,
+					DomainID:  w.domainID,
+					TxHash:    pendingTx.TxHash,
+					Status:    store.PendingExecution,
+					Attempts:  1,
+				}
+			}
+			executions[i] = execution
+		}
+
+		log.Info().Str("messageID", props[0].MessageID).Uint8("domainID", w.domainID).Msgf("Resuming watch of pending transaction %s", pendingTx.TxHash)
+		go w.wait(pendingTx, props, executions, onFailure)
+	}
+	return nil
+}
+
+// pendingTransaction fetches the nonce of the sent transaction and the current block from which
+// replacements are searched. Transactions without a nonce are tracked only by hash.
+func (w *EVMExecutionWatcher) pendingTransaction(props []*proposal.Proposal, txHash common.Hash, attempts uint64) *store.PendingTransaction {
+	pendingTx := &store.PendingTransaction{
+		TxHash:   txHash,
+		SentAt:   time.Now(),
+		Attempts: attempts,
+	}
+	encodedProps, err := message.MarshalProposals(props)
+	if err != nil {
+		log.Warn().Err(err).Str("messageID", props[0].MessageID).Msgf("Failed encoding proposals of transaction %s", txHash)
+	}
+	pendingTx.Proposals = encodedProps
+
+	tx, _, err := w.client.TransactionByHash(context.Background(), txHash)
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", w.domainID).Msgf("Failed fetching transaction %s, replacements won't be tracked", txHash)
+		return pendingTx
+	}
+	blockNumber, err := w.client.BlockNumber(context.Background())
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", w.domainID).Msgf("Failed fetching latest block, replacements of %s won't be tracked", txHash)
+		return pendingTx
+	}
+
+	nonce := tx.Nonce()
+	pendingTx.Nonce = &nonce
+	pendingTx.SentBlock = blockNumber
+	return pendingTx
+}
+
+func (w *EVMExecutionWatcher) wait(
+	pendingTx *store.PendingTransaction,
+	props []*proposal.Proposal,
+	executions []*store.Execution,
+	onFailure func(props []*proposal.Proposal, attempts uint64, err error),
+) {
+	err := w.waitReceipt(pendingTx, executions)
+	w.storeExecutions(executions)
+	w.removePendingTransaction(pendingTx.TxHash)
+	if err != nil {
+		w.metrics.TrackExecutionsFailed(w.domainID, len(props))
+		onFailure(props, pendingTx.Attempts, err)
+	}
+}
+
+// waitReceipt polls the transaction receipt and updates executions with the transaction result.
+// If the transaction nonce is used by a replacement transaction, the replacement receipt is polled instead.
+// An error is returned if the transaction failed or was not mined.
+func (w *EVMExecutionWatcher) waitReceipt(pendingTx *store.PendingTransaction, executions []*store.Execution) error {
+	txHash := pendingTx.TxHash
+	fromBlock := pendingTx.SentBlock
+	timeout := time.After(time.Until(pendingTx.SentAt.Add(w.receiptTimeout)))
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-timeout:
+			log.Warn().Uint8("domainID", w.domainID).Msgf("Transaction %s not mined in %s", txHash, w.receiptTimeout)
+			for _, execution := range executions {
+				execution.Status = store.DroppedExecution
+			}
+			return fmt.Errorf("transaction %s not mined in %s", txHash, w.receiptTimeout)
+		case <-ticker.C:
+			receipt, err := w.client.TransactionReceipt(context.Background(), txHash)
+			if err != nil {
+				if !errors.Is(err, ethereum.NotFound) {
+					log.Warn().Err(err).Uint8("domainID", w.domainID).Msgf("Failed fetching receipt of transaction %s", txHash)
+				}
+				if pendingTx.Nonce == nil {
+					continue
+				}
+
+				replacement, head, err := w.replacement(*pendingTx.Nonce, fromBlock)
+				if err != nil {
+					log.Warn().Err(err).Uint8("domainID", w.domainID).Msgf("Failed searching replacement of transaction %s", txHash)
+					continue
+				}
+				if replacement == nil {
+					fromBlock = head
+					continue
+				}
+				if *replacement != txHash {
+					log.Info().Uint8("domainID", w.domainID).Msgf("Transaction %s replaced by %s", txHash, replacement)
+					txHash = *replacement
+					for _, execution := range executions {
+						execution.TxHash = txHash
+					}
+				}
+				continue
+			}
+			w.metrics.TrackGasSpent(w.domainID, receipt.GasUsed)
+
+			status := store.SuccessfulExecution
+			revertReason := ""
+			if receipt.Status == types.ReceiptStatusFailed {
+				status = store.FailedExecution
+				revertReason = w.revertReason(txHash, receipt.BlockNumber)
+				log.Warn().Uint8("domainID", w.domainID).Msgf("Transaction %s failed: %s", txHash, revertReason)
+			}
+			for _, execution := range executions {
+				execution.Status = status
+				execution.BlockNumber = receipt.BlockNumber.Uint64()
+				execution.GasUsed = receipt.GasUsed
+				execution.RevertReason = revertReason
+			}
+			if status == store.FailedExecution {
+				return fmt.Errorf("transaction %s failed: %s", txHash, revertReason)
+			}
+			return nil
+		}
+	}
+}
+
+// replacement returns the hash of the mined sender transaction with the given nonce or nil if
+// the nonce is not used yet. Blocks are searched from fromBlock up to the returned head block.
+func (w *EVMExecutionWatcher) replacement(nonce uint64, fromBlock uint64) (*common.Hash, uint64, error) {
+	head, err := w.client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+	accountNonce, err := w.client.NonceAt(context.Background(), w.client.From(), new(big.Int).SetUint64(head))
+	if err != nil {
+		return nil, 0, err
+	}
+	if accountNonce <= nonce {
+		return nil, head, nil
+	}
+
+	for number := fromBlock; number <= head; number++ {
+		block, err := w.client.BlockByNumber(context.Background(), new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, tx := range block.Transactions() {
+			if tx.Nonce() != nonce {
+				continue
+			}
+			sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			if err == nil && sender == w.client.From() {
+				hash := tx.Hash()
+				return &hash, head, nil
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("transaction with nonce %d not found in blocks %d-%d", nonce, fromBlock, head)
+}
+
+// revertReason replays the failed transaction on the state of the parent block to fetch the revert reason
+func (w *EVMExecutionWatcher) revertReason(txHash common.Hash, blockNumber *big.Int) string {
+	tx, _, err := w.client.TransactionByHash(context.Background(), txHash)
+	if err != nil {
+		return err.Error()
+	}
+
+	msg := ethereum.CallMsg{
+		From:  w.client.From(),
+		To:    tx.To(),
+		Data:  tx.Data(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+	}
+	_, err = w.client.CallContract(context.Background(), client.ToCallArg(msg), new(big.Int).Sub(blockNumber, big.NewInt(1)))
+	if err == nil {
+		return "unknown"
+	}
+	return contracts.DecodeRevert(err).Error()
+}
+
+func (w *EVMExecutionWatcher) storeExecutions(executions []*store.Execution) {
+	for _, execution := range executions {
+		err := w.executionStore.StoreExecution(execution)
+		if err != nil {
+			log.Err(err).Str("messageID", execution.MessageID).Msgf("Failed storing execution status")
+		}
+	}
+}
+
+func (w *EVMExecutionWatcher) removePendingTransaction(txHash common.Hash) {
+	err := w.executionStore.RemovePendingTransaction(w.domainID, txHash)
+	if err != nil {
+		log.Err(err).Uint8("domainID", w.domainID).Msgf("Failed removing pending transaction %s", txHash)
+	}
+}
@@ -0,0 +1,268 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor_test
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"go.uber.org/mock/gomock"
+)
+
+type ExecutionWatcherTestSuite struct {
+	suite.Suite
+
+	watcher            *executor.EVMExecutionWatcher
+	mockClient         *mock.MockWatcherClient
+	mockExecutionStore *mock.MockExecutionStorer
+	mockMetrics        *mock.MockWatcherMetrics
+	props              []*proposal.Proposal
+	txHash             common.Hash
+	key                *ecdsa.PrivateKey
+	failed             chan error
+	stored             chan *store.Execution
+	removed            chan common.Hash
+}
+
+func TestRunExecutionWatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutionWatcherTestSuite))
+}
+
+func (s *ExecutionWatcherTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockClient = mock.NewMockWatcherClient(ctrl)
+	s.mockExecutionStore = mock.NewMockExecutionStorer(ctrl)
+	s.mockMetrics = mock.NewMockWatcherMetrics(ctrl)
+	s.watcher = executor.NewEVMExecutionWatcher(2, s.mockClient, s.mockExecutionStore, s.mockMetrics, time.Millisecond)
+	s.props = []*proposal.Proposal{
+		proposal.NewProposal(1, 2, message.HashiData{}, "message", message.HashiProposal),
+	}
+	s.txHash = common.HexToHash("0x1")
+	s.key, _ = crypto.GenerateKey()
+	s.failed = make(chan error, 1)
+	s.stored = make(chan *store.Execution, 2)
+	s.removed = make(chan common.Hash, 1)
+
+	s.mockClient.EXPECT().From().Return(crypto.PubkeyToAddress(s.key.PublicKey)).AnyTimes()
+	s.mockClient.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil).AnyTimes()
+	s.mockExecutionStore.EXPECT().StoreExecution(gomock.Any()).DoAndReturn(func(execution *store.Execution) error {
+		stored := *execution
+		s.stored <- &stored
+		return nil
+	}).AnyTimes()
+	s.mockExecutionStore.EXPECT().StorePendingTransaction(uint8(2), gomock.Any()).Return(nil).AnyTimes()
+	s.mockExecutionStore.EXPECT().RemovePendingTransaction(uint8(2), gomock.Any()).DoAndReturn(func(_ uint8, txHash common.Hash) error {
+		s.removed <- txHash
+		return nil
+	}).AnyTimes()
+}
+
+func (s *ExecutionWatcherTestSuite) onFailure(props []*proposal.Proposal, attempts uint64, err error) {
+	s.Equal(props, s.props)
+	s.Equal(attempts, uint64(1))
+	s.failed <- err
+}
+
+func (s *ExecutionWatcherTestSuite) signedTx(nonce uint64) *types.Transaction {
+	tx, _ := types.SignTx(
+		types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: nonce}),
+		types.LatestSignerForChainID(big.NewInt(1)),
+		s.key)
+	return tx
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Watch_SuccessfulExecution() {
+	s.mockExecutionStore.EXPECT().Execution("message").Return(nil, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.txHash).Return(s.signedTx(5), true, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), crypto.PubkeyToAddress(s.key.PublicKey), big.NewInt(100)).Return(uint64(5), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(100),
+		GasUsed:     50000,
+	}, nil)
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(2), uint64(50000))
+
+	s.watcher.Watch(s.props, s.txHash, 1, s.onFailure)
+
+	s.Equal(<-s.stored, &store.Execution{
+		MessageID: "message",
+		DomainID:  2,
+		TxHash:    s.txHash,
+		Status:    store.PendingExecution,
+		Attempts:  1,
+	})
+	s.Equal(<-s.stored, &store.Execution{
+		MessageID:   "message",
+		DomainID:    2,
+		TxHash:      s.txHash,
+		Status:      store.SuccessfulExecution,
+		BlockNumber: 100,
+		GasUsed:     50000,
+		Attempts:    1,
+	})
+	s.Equal(<-s.removed, s.txHash)
+	select {
+	case <-s.failed:
+		s.Fail("successful execution failed")
+	case <-time.After(time.Millisecond * 10):
+	}
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Watch_FailedExecution() {
+	to := common.HexToAddress("0x2")
+	s.mockExecutionStore.EXPECT().Execution("message").Return(nil, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(&types.Receipt{
+		Status:      types.ReceiptStatusFailed,
+		BlockNumber: big.NewInt(100),
+		GasUsed:     50000,
+	}, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.txHash).Return(types.NewTx(&types.LegacyTx{
+		To:   &to,
+		Data: []byte{1},
+	}), false, nil).Times(2)
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(99)).Return(nil, fmt.Errorf("execution reverted"))
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(2), uint64(50000))
+	s.mockMetrics.EXPECT().TrackExecutionsFailed(uint8(2), 1)
+
+	s.watcher.Watch(s.props, s.txHash, 1, s.onFailure)
+
+	<-s.stored
+	s.Equal(<-s.stored, &store.Execution{
+		MessageID:    "message",
+		DomainID:     2,
+		TxHash:       s.txHash,
+		Status:       store.FailedExecution,
+		BlockNumber:  100,
+		GasUsed:      50000,
+		RevertReason: "execution reverted",
+		Attempts:     1,
+	})
+	s.NotNil(<-s.failed)
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Watch_AttemptsIncreased() {
+	s.mockExecutionStore.EXPECT().Execution("message").Return(&store.Execution{
+		MessageID: "message",
+		Status:    store.FailedExecution,
+		Attempts:  1,
+	}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(&types.Receipt{
+		Status:      types.ReceiptStatusFailed,
+		BlockNumber: big.NewInt(100),
+	}, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.txHash).Return(nil, false, fmt.Errorf("error")).Times(2)
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(2), uint64(0))
+	s.mockMetrics.EXPECT().TrackExecutionsFailed(uint8(2), 1)
+
+	s.watcher.Watch(s.props, s.txHash, 1, s.onFailure)
+
+	s.Equal((<-s.stored).Attempts, uint64(2))
+	s.Equal((<-s.stored).Status, store.FailedExecution)
+	s.NotNil(<-s.failed)
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Watch_ReplacedTransaction() {
+	replacementTx := s.signedTx(5)
+	s.mockExecutionStore.EXPECT().Execution("message").Return(nil, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.txHash).Return(s.signedTx(5), true, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), crypto.PubkeyToAddress(s.key.PublicKey), big.NewInt(100)).Return(uint64(6), nil)
+	s.mockClient.EXPECT().BlockByNumber(gomock.Any(), big.NewInt(100)).Return(
+		types.NewBlockWithHeader(&types.Header{Number: big.NewInt(100)}).WithBody(
+			[]*types.Transaction{s.signedTx(4), replacementTx}, nil), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), replacementTx.Hash()).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(100),
+		GasUsed:     50000,
+	}, nil)
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(2), uint64(50000))
+
+	s.watcher.Watch(s.props, s.txHash, 1, s.onFailure)
+
+	<-s.stored
+	s.Equal(<-s.stored, &store.Execution{
+		MessageID:   "message",
+		DomainID:    2,
+		TxHash:      replacementTx.Hash(),
+		Status:      store.SuccessfulExecution,
+		BlockNumber: 100,
+		GasUsed:     50000,
+		Attempts:    1,
+	})
+	s.Equal(<-s.removed, s.txHash)
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Resume_PendingTransaction() {
+	encodedProps, _ := message.MarshalProposals(s.props)
+	s.mockExecutionStore.EXPECT().PendingTransactions(uint8(2)).Return([]*store.PendingTransaction{
+		{
+			TxHash:    s.txHash,
+			SentAt:    time.Now(),
+			Attempts:  1,
+			Proposals: encodedProps,
+		},
+	}, nil)
+	s.mockExecutionStore.EXPECT().Execution("message").Return(&store.Execution{
+		MessageID: "message",
+		DomainID:  2,
+		TxHash:    s.txHash,
+		Status:    store.PendingExecution,
+		Attempts:  2,
+	}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(100),
+		GasUsed:     50000,
+	}, nil)
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(2), uint64(50000))
+
+	err := s.watcher.Resume(s.onFailure)
+
+	s.Nil(err)
+	s.Equal(<-s.stored, &store.Execution{
+		MessageID:   "message",
+		DomainID:    2,
+		TxHash:      s.txHash,
+		Status:      store.SuccessfulExecution,
+		BlockNumber: 100,
+		GasUsed:     50000,
+		Attempts:    2,
+	})
+	s.Equal(<-s.removed, s.txHash)
+}
+
+func (s *ExecutionWatcherTestSuite) Test_Resume_ExpiredTransactionDropped() {
+	encodedProps, _ := message.MarshalProposals(s.props)
+	s.mockExecutionStore.EXPECT().PendingTransactions(uint8(2)).Return([]*store.PendingTransaction{
+		{
+			TxHash:    s.txHash,
+			SentAt:    time.Now().Add(-executor.RECEIPT_TIMEOUT),
+			Attempts:  1,
+			Proposals: encodedProps,
+		},
+	}, nil)
+	s.mockExecutionStore.EXPECT().Execution("message").Return(nil, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.txHash).Return(nil, ethereum.NotFound).AnyTimes()
+	s.mockMetrics.EXPECT().TrackExecutionsFailed(uint8(2), 1)
+
+	err := s.watcher.Resume(s.onFailure)
+
+	s.Nil(err)
+	s.Equal((<-s.stored).Status, store.DroppedExecution)
+	s.NotNil(<-s.failed)
+}
@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"slices"
 	"strings"
+	"time"
 
 	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -37,6 +38,11 @@ type Client interface {
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
+type DepositMetrics interface {
+	TrackDepositsFound(domainID uint8, destinationDomainID uint8, count int)
+	TrackProofLatency(proofType string, duration time.Duration)
+}
+
 type StorageProof struct {
 	Proof []string `json:"proof"`
 }
@@ -54,6 +60,7 @@ type DepositEventHandler struct {
 	routerABI        ethereumABI.ABI
 	slotIndex        uint8
 	genericResources []string
+	metrics          DepositMetrics
 	msgChan          chan []*message.Message
 }
 
@@ -63,6 +70,7 @@ func NewDepositEventHandler(
 	routerAddres common.Address,
 	slotIndex uint8,
 	genericResources []string,
+	metrics DepositMetrics,
 	msgChan chan []*message.Message) *DepositEventHandler {
 	routerABI, _ := ethereumABI.JSON(strings.NewReader(abi.RouterABI))
 	return &DepositEventHandler{
@@ -72,6 +80,7 @@ func NewDepositEventHandler(
 		routerABI:        routerABI,
 		slotIndex:        slotIndex,
 		genericResources: genericResources,
+		metrics:          metrics,
 		msgChan:          msgChan,
 		domainID:         domainID,
 	}
@@ -82,12 +91,16 @@ func (h *DepositEventHandler) HandleEvents(destination uint8, startBlock *big.In
 	if err != nil {
 		return err
 	}
+	h.metrics.TrackDepositsFound(h.domainID, destination, len(deposits))
+
 	msgs := make(map[uint8][]*message.Message)
 	for _, d := range deposits {
-		accountProof, storageProof, err := h.proof(endBlock, d)
+		start := time.Now()
+		accountProof, storageProof, err := h.Proof(endBlock, d)
 		if err != nil {
 			return err
 		}
+		h.metrics.TrackProofLatency("storage", time.Since(start))
 
 		msgID := fmt.Sprintf("%d-%d-%d-%d", slot, h.domainID, d.DestinationDomainID, d.DepositNonce)
 		h.log.Debug().Str("messageID", msgID).Uint8("destination", d.DestinationDomainID).Msg("Sending transfer message")
@@ -133,7 +146,8 @@ func (h *DepositEventHandler) fetchDeposits(destinationDomain uint8, startBlock
 	return deposits, nil
 }
 
-func (h *DepositEventHandler) proof(
+// Proof returns the router account proof and the storage proof of the deposit slot at the given block
+func (h *DepositEventHandler) Proof(
 	blockNumber *big.Int,
 	deposit *events.Deposit,
 ) ([]string, []string, error) {
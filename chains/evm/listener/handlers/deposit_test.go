@@ -29,6 +29,7 @@ type DepositHandlerTestSuite struct {
 	mockClient        *mock.MockClient
 	mockBlockStorer   *mock.MockBlockStorer
 	mockBlockFetcher  *mock.MockBlockFetcher
+	mockMetrics       *mock.MockDepositMetrics
 	sourceDomain      uint8
 	destinationDomain uint8
 	slotIndex         uint8
@@ -44,6 +45,9 @@ func (s *DepositHandlerTestSuite) SetupTest() {
 	s.mockClient = mock.NewMockClient(ctrl)
 	s.mockBlockFetcher = mock.NewMockBlockFetcher(ctrl)
 	s.mockBlockStorer = mock.NewMockBlockStorer(ctrl)
+	s.mockMetrics = mock.NewMockDepositMetrics(ctrl)
+	s.mockMetrics.EXPECT().TrackDepositsFound(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockMetrics.EXPECT().TrackProofLatency("storage", gomock.Any()).AnyTimes()
 	s.msgChan = make(chan []*evmMessage.Message, 2)
 	s.sourceDomain = 1
 	s.destinationDomain = 2
@@ -55,6 +59,7 @@ func (s *DepositHandlerTestSuite) SetupTest() {
 		s.routerAddress,
 		s.slotIndex,
 		[]string{"0x0000000000000000000000000000000000000000000000000000000000000500"},
+		s.mockMetrics,
 		s.msgChan,
 	)
 }
@@ -4,16 +4,19 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	ethereumABI "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/rs/zerolog"
@@ -26,10 +29,17 @@ import (
 
 type ReceiptProver interface {
 	ReceiptProof(txHash common.Hash) ([][]byte, error)
+	VerifyReceiptProof(receiptsRoot common.Hash, txIndexRLP []byte, proof [][]byte, logIndex *big.Int) (*types.Log, error)
 }
 
 type RootProver interface {
 	ReceiptsRootProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) ([][]byte, error)
+	VerifyReceiptsRootProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int, receiptsRoot [32]byte, proof [][]byte) error
+}
+
+type HashiMetrics interface {
+	TrackHashiMessagesFound(domainID uint8, destinationDomainID uint8, count int)
+	TrackProofLatency(proofType string, duration time.Duration)
 }
 
 type BeaconClient interface {
@@ -52,6 +62,7 @@ type HashiEventHandler struct {
 	rootProver    RootProver
 	beaconClient  BeaconClient
 	client        Client
+	metrics       HashiMetrics
 	chainIDS      map[uint8]uint64
 }
 
@@ -63,6 +74,7 @@ func NewHashiEventHandler(
 	rootProver RootProver,
 	yahoAddress common.Address,
 	chainIDS map[uint8]uint64,
+	metrics HashiMetrics,
 	msgChan chan []*message.Message) *HashiEventHandler {
 	abi, _ := ethereumABI.JSON(strings.NewReader(abi.YahoABI))
 	return &HashiEventHandler{
@@ -75,6 +87,7 @@ func NewHashiEventHandler(
 		receiptProver: receiptProver,
 		rootProver:    rootProver,
 		chainIDS:      chainIDS,
+		metrics:       metrics,
 		msgChan:       msgChan,
 	}
 }
@@ -98,13 +111,44 @@ func (h *HashiEventHandler) HandleEvents(destination uint8, startBlock *big.Int,
 		log.Info().Str("messageID", msg.ID).Msgf("Found hashi message log in block: %d, TxHash: %s, %+v", l.BlockNumber, l.TxHash, msg)
 		msgs = append(msgs, msg)
 	}
-
-	for _, msg := range msgs {
-		h.msgChan <- []*message.Message{msg}
+	h.metrics.TrackHashiMessagesFound(h.domainID, destination, len(msgs))
+	if len(msgs) == 0 {
+		return nil
 	}
+
+	h.msgChan <- msgs
 	return nil
 }
 
+// Message builds the hashi message of the log with the given block log index emitted by the transaction.
+// The message is proven against the given state root slot.
+func (h *HashiEventHandler) Message(txHash common.Hash, logIndex uint, destination uint8, slot *big.Int) (*message.Message, error) {
+	receipt, err := h.client.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range receipt.Logs {
+		if l.Index != logIndex {
+			continue
+		}
+		if l.Address != h.yahoAddress || len(l.Topics) == 0 || l.Topics[0] != crypto.Keccak256Hash([]byte(events.MessageDispatchedSig)) {
+			return nil, fmt.Errorf("log %d of tx %s is not a yaho message", logIndex, txHash)
+		}
+
+		msg, err := h.handleMessage(*l, destination, slot)
+		if err != nil {
+			return nil, err
+		}
+		if msg == nil {
+			return nil, fmt.Errorf("message of tx %s is not sent to domain %d", txHash, destination)
+		}
+		return msg, nil
+	}
+
+	return nil, fmt.Errorf("log %d not found in tx %s", logIndex, txHash)
+}
+
 func (h *HashiEventHandler) handleMessage(l types.Log, destination uint8, slot *big.Int) (*message.Message, error) {
 	msg, err := h.unpackMessage(l.Data)
 	if err != nil {
@@ -138,30 +182,53 @@ func (h *HashiEventHandler) handleMessage(l types.Log, destination uint8, slot *
 		return nil, err
 	}
 
+	start := time.Now()
 	rootProof, err := h.rootProver.ReceiptsRootProof(context.Background(), slot, txSlot)
 	if err != nil {
 		return nil, err
 	}
+	h.metrics.TrackProofLatency("receiptsRoot", time.Since(start))
+	err = h.rootProver.VerifyReceiptsRootProof(context.Background(), slot, txSlot, block.ReceiptHash(), rootProof)
+	if err != nil {
+		return nil, fmt.Errorf("receipts root proof for tx %s failed verification: %w", l.TxHash, err)
+	}
 
 	txIndexRLP, err := rlp.EncodeToBytes(receipt.TransactionIndex)
 	if err != nil {
 		return nil, err
 	}
 
+	start = time.Now()
 	receiptProof, err := h.receiptProver.ReceiptProof(l.TxHash)
 	if err != nil {
 		return nil, err
 	}
+	h.metrics.TrackProofLatency("receipt", time.Since(start))
+
+	logIndex := h.logIndex(receipt, l)
+	provenLog, err := h.receiptProver.VerifyReceiptProof(block.ReceiptHash(), txIndexRLP, receiptProof, logIndex)
+	if err != nil {
+		return nil, fmt.Errorf("receipt proof for tx %s failed verification: %w", l.TxHash, err)
+	}
+	if !h.isSameLog(provenLog, l) {
+		return nil, fmt.Errorf("receipt proof for tx %s proves a different log at index %d", l.TxHash, logIndex)
+	}
+
+	var dispatchedMessageID *big.Int
+	if len(l.Topics) > 1 {
+		dispatchedMessageID = new(big.Int).SetBytes(l.Topics[1].Bytes())
+	}
 
 	return evmMessage.NewHashiMessage(h.domainID, destination, evmMessage.HashiData{
-		SrcSlot:           slot,
-		TxSlot:            txSlot,
-		ReceiptProof:      receiptProof,
-		ReceiptRootProof:  rootProof,
-		ReceiptRoot:       block.ReceiptHash(),
-		TxIndexRLPEncoded: txIndexRLP,
-		LogIndex:          h.logIndex(receipt, l),
-	}, fmt.Sprintf("%s-%d", l.TxHash, h.logIndex(receipt, l))), nil
+		SrcSlot:             slot,
+		TxSlot:              txSlot,
+		ReceiptProof:        receiptProof,
+		ReceiptRootProof:    rootProof,
+		ReceiptRoot:         block.ReceiptHash(),
+		TxIndexRLPEncoded:   txIndexRLP,
+		LogIndex:            logIndex,
+		DispatchedMessageID: dispatchedMessageID,
+	}, fmt.Sprintf("%s-%d", l.TxHash, logIndex)), nil
 }
 
 func (h *HashiEventHandler) slotChild(slot *big.Int) (*big.Int, error) {
@@ -198,6 +265,23 @@ func (h *HashiEventHandler) logIndex(receipt *types.Receipt, log types.Log) *big
 	return big.NewInt(0)
 }
 
+// isSameLog compares consensus fields of the proven log with the log fetched from the node
+func (h *HashiEventHandler) isSameLog(provenLog *types.Log, l types.Log) bool {
+	if provenLog.Address != l.Address || !bytes.Equal(provenLog.Data, l.Data) {
+		return false
+	}
+	if len(provenLog.Topics) != len(l.Topics) {
+		return false
+	}
+	for i, topic := range provenLog.Topics {
+		if topic != l.Topics[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (h *HashiEventHandler) fetchMessages(startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
 	return fetchLogs(h.client, startBlock, endBlock, h.yahoAddress, string(events.MessageDispatchedSig))
 }
@@ -6,6 +6,7 @@ package handlers_test
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -14,8 +15,11 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 	evmMessage "github.com/sygmaprotocol/sygma-core/relayer/message"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/events"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
@@ -33,9 +37,11 @@ type HashiHandlerTestSuite struct {
 	mockBeaconClient  *mock.MockBeaconClient
 	mockReceiptProver *mock.MockReceiptProver
 	mockRootProver    *mock.MockRootProver
+	mockMetrics       *mock.MockHashiMetrics
 	sourceDomain      uint8
 	destinationDomain uint8
 	yahoAddress       common.Address
+	messageData       []byte
 }
 
 func TestRunHashiHandlerTestSuite(t *testing.T) {
@@ -48,10 +54,14 @@ func (s *HashiHandlerTestSuite) SetupTest() {
 	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
 	s.mockReceiptProver = mock.NewMockReceiptProver(ctrl)
 	s.mockRootProver = mock.NewMockRootProver(ctrl)
-	s.msgChan = make(chan []*evmMessage.Message, 2)
+	s.mockMetrics = mock.NewMockHashiMetrics(ctrl)
+	s.mockMetrics.EXPECT().TrackHashiMessagesFound(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockMetrics.EXPECT().TrackProofLatency(gomock.Any(), gomock.Any()).AnyTimes()
+	s.msgChan = make(chan []*evmMessage.Message, 3)
 	s.sourceDomain = 1
 	s.destinationDomain = 2
 	s.yahoAddress = common.HexToAddress("0xa83114A443dA1CecEFC50368531cACE9F37fCCcb")
+	s.messageData, _ = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000027d800000000000000000000000000000000000000000000000000000000000000010000000000000000000000001c3a03d04c026b1f4b4208d2ce053c5686e6fb8d000000000000000000000000ba9165973963a6e5608f03b9648c34a737e48f68000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000180000000000000000000000000000000000000000000000000000000000000000b48656c6c6f20776f726c64000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000ba9165973963a6e5608f03b9648c34a737e48f68")
 	chainIDS := make(map[uint8]uint64)
 	chainIDS[2] = 10200
 	s.hashiHandler = handlers.NewHashiEventHandler(
@@ -62,17 +72,17 @@ func (s *HashiHandlerTestSuite) SetupTest() {
 		s.mockRootProver,
 		s.yahoAddress,
 		chainIDS,
+		s.mockMetrics,
 		s.msgChan,
 	)
 }
 
 func (s *HashiHandlerTestSuite) Test_HandleEvents_ValidMessage() {
 	txHash := common.HexToHash("0x12345")
-	messageData, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000027d800000000000000000000000000000000000000000000000000000000000000010000000000000000000000001c3a03d04c026b1f4b4208d2ce053c5686e6fb8d000000000000000000000000ba9165973963a6e5608f03b9648c34a737e48f68000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000180000000000000000000000000000000000000000000000000000000000000000b48656c6c6f20776f726c64000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000ba9165973963a6e5608f03b9648c34a737e48f68")
 	s.mockClient.EXPECT().FetchEventLogs(context.Background(), s.yahoAddress, string(events.MessageDispatchedSig), big.NewInt(80), big.NewInt(100)).Return(
 		[]types.Log{
 			{
-				Data:   messageData,
+				Data:   s.messageData,
 				TxHash: txHash,
 			},
 		},
@@ -92,7 +102,11 @@ func (s *HashiHandlerTestSuite) Test_HandleEvents_ValidMessage() {
 		},
 	}, nil).AnyTimes()
 	s.mockReceiptProver.EXPECT().ReceiptProof(gomock.Any()).Return([][]byte{{1}}, nil)
+	s.mockReceiptProver.EXPECT().VerifyReceiptProof(gomock.Any(), gomock.Any(), [][]byte{{1}}, big.NewInt(0)).Return(&types.Log{
+		Data: s.messageData,
+	}, nil)
 	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), gomock.Any(), gomock.Any()).Return([][]byte{{2}}, nil)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(nil)
 
 	err := s.hashiHandler.HandleEvents(s.destinationDomain, big.NewInt(80), big.NewInt(100), big.NewInt(150))
 
@@ -102,3 +116,245 @@ func (s *HashiHandlerTestSuite) Test_HandleEvents_ValidMessage() {
 	s.Equal(len(msgs), 1)
 	s.Equal(msgs[0].Type, message.HashiMessage)
 }
+
+func (s *HashiHandlerTestSuite) Test_HandleEvents_MessagesAggregated() {
+	logs := make([]types.Log, 3)
+	for i := range logs {
+		logs[i] = types.Log{
+			Data:   s.messageData,
+			TxHash: common.BigToHash(big.NewInt(int64(i + 1))),
+			Topics: []common.Hash{crypto.Keccak256Hash([]byte(events.MessageDispatchedSig)), common.BigToHash(big.NewInt(int64(i + 1)))},
+		}
+	}
+	s.mockClient.EXPECT().FetchEventLogs(context.Background(), s.yahoAddress, string(events.MessageDispatchedSig), big.NewInt(80), big.NewInt(100)).Return(logs, nil)
+	s.mockClient.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(types.NewBlock(&types.Header{
+		ParentBeaconRoot: &common.Hash{},
+	}, nil, nil, nil, nil), nil).Times(3)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{}, nil).Times(3)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(121),
+				},
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockReceiptProver.EXPECT().ReceiptProof(gomock.Any()).Return([][]byte{{1}}, nil).Times(3)
+	for _, l := range logs {
+		s.mockReceiptProver.EXPECT().VerifyReceiptProof(gomock.Any(), gomock.Any(), [][]byte{{1}}, big.NewInt(0)).Return(&types.Log{
+			Data:   s.messageData,
+			Topics: l.Topics,
+		}, nil)
+	}
+	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), gomock.Any(), gomock.Any()).Return([][]byte{{2}}, nil).Times(3)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(nil).Times(3)
+
+	ctrl := gomock.NewController(s.T())
+	mockHashiAdapter := mock.NewMockHashiContract(ctrl)
+	mockMulticall := mock.NewMockMulticallContract(ctrl)
+	mockGasEstimator := mock.NewMockGasEstimator(ctrl)
+	mockWatcher := mock.NewMockExecutionWatcher(ctrl)
+	mockMetrics := mock.NewMockExecutorMetrics(ctrl)
+	mockMetrics.EXPECT().TrackExecutionsSubmitted(s.destinationDomain, gomock.Any()).AnyTimes()
+	mockHashiAdapter.EXPECT().ContractAddress().Return(&common.Address{}).AnyTimes()
+	mockHashiAdapter.EXPECT().MessageHash(gomock.Any()).Return([32]byte{}, nil).Times(3)
+	mockHashiAdapter.EXPECT().VerifyAndStoreDispatchedMessageCalldata(
+		uint64(150), uint64(121), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte{1}, nil).Times(3)
+	mockMulticall.EXPECT().ContractAddress().Return(&common.Address{1}).AnyTimes()
+	mockMulticall.EXPECT().SimulateAggregate3(gomock.Len(3)).Return(nil)
+	mockMulticall.EXPECT().Aggregate3Calldata(gomock.Len(3)).Return([]byte{2}, nil)
+	mockGasEstimator.EXPECT().EstimateGas(&common.Address{1}, []byte{2}, uint64(3*executor.HASHI_GAS_COST)).Return(uint64(5000000))
+	mockMulticall.EXPECT().Aggregate3(gomock.Len(3), gomock.Any()).Return(&common.Hash{1}, nil).Times(1)
+	mockWatcher.EXPECT().Watch(gomock.Len(3), common.Hash{1}, gomock.Any(), gomock.Any())
+	hashiExecutor := executor.NewEVMExecutor(
+		s.destinationDomain, nil, mockHashiAdapter, mockMulticall, mockGasEstimator, mockWatcher, nil, nil, mockMetrics, 10000000, false)
+
+	err := s.hashiHandler.HandleEvents(s.destinationDomain, big.NewInt(80), big.NewInt(100), big.NewInt(150))
+
+	s.Nil(err)
+	msgs, err := readFromChannel(s.msgChan)
+	s.Nil(err)
+	s.Equal(len(msgs), 3)
+	_, err = readFromChannel(s.msgChan)
+	s.NotNil(err)
+	props := make([]*proposal.Proposal, len(msgs))
+	for i, msg := range msgs {
+		props[i], err = (&message.HashiMessageHandler{}).HandleMessage(msg)
+		s.Nil(err)
+	}
+	err = hashiExecutor.Execute(props)
+	s.Nil(err)
+}
+
+func (s *HashiHandlerTestSuite) Test_HandleEvents_InvalidReceiptProof() {
+	txHash := common.HexToHash("0x12345")
+	s.mockClient.EXPECT().FetchEventLogs(context.Background(), s.yahoAddress, string(events.MessageDispatchedSig), big.NewInt(80), big.NewInt(100)).Return(
+		[]types.Log{
+			{
+				Data:   s.messageData,
+				TxHash: txHash,
+			},
+		},
+		nil,
+	)
+	s.mockClient.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(types.NewBlock(&types.Header{
+		ParentBeaconRoot: &common.Hash{},
+	}, nil, nil, nil, nil), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{}, nil)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(121),
+				},
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockReceiptProver.EXPECT().ReceiptProof(gomock.Any()).Return([][]byte{{1}}, nil)
+	s.mockReceiptProver.EXPECT().VerifyReceiptProof(gomock.Any(), gomock.Any(), [][]byte{{1}}, big.NewInt(0)).Return(nil, fmt.Errorf("error"))
+	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), gomock.Any(), gomock.Any()).Return([][]byte{{2}}, nil)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(nil)
+
+	err := s.hashiHandler.HandleEvents(s.destinationDomain, big.NewInt(80), big.NewInt(100), big.NewInt(150))
+
+	s.NotNil(err)
+	_, err = readFromChannel(s.msgChan)
+	s.NotNil(err)
+}
+
+func (s *HashiHandlerTestSuite) Test_HandleEvents_ProofForDifferentLog() {
+	txHash := common.HexToHash("0x12345")
+	s.mockClient.EXPECT().FetchEventLogs(context.Background(), s.yahoAddress, string(events.MessageDispatchedSig), big.NewInt(80), big.NewInt(100)).Return(
+		[]types.Log{
+			{
+				Data:   s.messageData,
+				TxHash: txHash,
+			},
+		},
+		nil,
+	)
+	s.mockClient.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(types.NewBlock(&types.Header{
+		ParentBeaconRoot: &common.Hash{},
+	}, nil, nil, nil, nil), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{}, nil)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(121),
+				},
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockReceiptProver.EXPECT().ReceiptProof(gomock.Any()).Return([][]byte{{1}}, nil)
+	s.mockReceiptProver.EXPECT().VerifyReceiptProof(gomock.Any(), gomock.Any(), [][]byte{{1}}, big.NewInt(0)).Return(&types.Log{
+		Data: []byte{1},
+	}, nil)
+	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), gomock.Any(), gomock.Any()).Return([][]byte{{2}}, nil)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(nil)
+
+	err := s.hashiHandler.HandleEvents(s.destinationDomain, big.NewInt(80), big.NewInt(100), big.NewInt(150))
+
+	s.NotNil(err)
+	_, err = readFromChannel(s.msgChan)
+	s.NotNil(err)
+}
+
+func (s *HashiHandlerTestSuite) Test_HandleEvents_InvalidReceiptsRootProof() {
+	txHash := common.HexToHash("0x12345")
+	s.mockClient.EXPECT().FetchEventLogs(context.Background(), s.yahoAddress, string(events.MessageDispatchedSig), big.NewInt(80), big.NewInt(100)).Return(
+		[]types.Log{
+			{
+				Data:   s.messageData,
+				TxHash: txHash,
+			},
+		},
+		nil,
+	)
+	s.mockClient.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(types.NewBlock(&types.Header{
+		ParentBeaconRoot: &common.Hash{},
+	}, nil, nil, nil, nil), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{}, nil)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(121),
+				},
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), gomock.Any(), gomock.Any()).Return([][]byte{{2}}, nil)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(fmt.Errorf("error"))
+
+	err := s.hashiHandler.HandleEvents(s.destinationDomain, big.NewInt(80), big.NewInt(100), big.NewInt(150))
+
+	s.NotNil(err)
+	_, err = readFromChannel(s.msgChan)
+	s.NotNil(err)
+}
+
+func (s *HashiHandlerTestSuite) Test_Message_ValidMessage() {
+	txHash := common.HexToHash("0x12345")
+	yahoLog := &types.Log{
+		Address: s.yahoAddress,
+		Topics:  []common.Hash{crypto.Keccak256Hash([]byte(events.MessageDispatchedSig)), common.BigToHash(big.NewInt(7))},
+		Data:    s.messageData,
+		TxHash:  txHash,
+		Index:   3,
+	}
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{
+		Logs: []*types.Log{yahoLog},
+	}, nil).Times(2)
+	s.mockClient.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(types.NewBlock(&types.Header{
+		ParentBeaconRoot: &common.Hash{},
+	}, nil, nil, nil, nil), nil)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(121),
+				},
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockReceiptProver.EXPECT().ReceiptProof(txHash).Return([][]byte{{1}}, nil)
+	s.mockReceiptProver.EXPECT().VerifyReceiptProof(gomock.Any(), gomock.Any(), [][]byte{{1}}, big.NewInt(0)).Return(yahoLog, nil)
+	s.mockRootProver.EXPECT().ReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121)).Return([][]byte{{2}}, nil)
+	s.mockRootProver.EXPECT().VerifyReceiptsRootProof(gomock.Any(), big.NewInt(150), big.NewInt(121), gomock.Any(), [][]byte{{2}}).Return(nil)
+
+	msg, err := s.hashiHandler.Message(txHash, 3, s.destinationDomain, big.NewInt(150))
+
+	s.Nil(err)
+	s.Equal(msg.Type, message.HashiMessage)
+	s.Equal(msg.ID, fmt.Sprintf("%s-%d", txHash, 0))
+	s.Equal(msg.Data.(message.HashiData).SrcSlot, big.NewInt(150))
+	s.Equal(msg.Data.(message.HashiData).DispatchedMessageID, big.NewInt(7))
+}
+
+func (s *HashiHandlerTestSuite) Test_Message_MissingLog() {
+	txHash := common.HexToHash("0x12345")
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{
+		Logs: []*types.Log{{Address: s.yahoAddress, Index: 2}},
+	}, nil)
+
+	_, err := s.hashiHandler.Message(txHash, 3, s.destinationDomain, big.NewInt(150))
+
+	s.NotNil(err)
+}
+
+func (s *HashiHandlerTestSuite) Test_Message_NotYahoLog() {
+	txHash := common.HexToHash("0x12345")
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(&types.Receipt{
+		Logs: []*types.Log{{
+			Address: common.HexToAddress("0x1"),
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte(events.MessageDispatchedSig))},
+			Index:   3,
+		}},
+	}, nil)
+
+	_, err := s.hashiHandler.Message(txHash, 3, s.destinationDomain, big.NewInt(150))
+
+	s.NotNil(err)
+}
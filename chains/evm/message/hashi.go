@@ -16,13 +16,14 @@ const (
 )
 
 type HashiData struct {
-	SrcSlot           *big.Int
-	TxSlot            *big.Int
-	ReceiptRootProof  [][]byte
-	ReceiptRoot       [32]byte
-	ReceiptProof      [][]byte
-	TxIndexRLPEncoded []byte
-	LogIndex          *big.Int
+	SrcSlot             *big.Int
+	TxSlot              *big.Int
+	ReceiptRootProof    [][]byte
+	ReceiptRoot         [32]byte
+	ReceiptProof        [][]byte
+	TxIndexRLPEncoded   []byte
+	LogIndex            *big.Int
+	DispatchedMessageID *big.Int
 }
 
 func NewHashiMessage(source uint8, destination uint8, data HashiData, messageID string) *message.Message {
@@ -43,5 +44,6 @@ func (h *HashiMessageHandler) HandleMessage(m *message.Message) (*proposal.Propo
 		Destination: m.Destination,
 		Type:        HashiProposal,
 		Data:        m.Data,
+		MessageID:   m.ID,
 	}, nil
 }
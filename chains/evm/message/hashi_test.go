@@ -0,0 +1,37 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+)
+
+type HashiMessageHandlerTestSuite struct {
+	suite.Suite
+
+	hashiHandler *message.HashiMessageHandler
+}
+
+func TestRunHashiMessageHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HashiMessageHandlerTestSuite))
+}
+
+func (s *HashiMessageHandlerTestSuite) SetupTest() {
+	s.hashiHandler = &message.HashiMessageHandler{}
+}
+
+func (s *HashiMessageHandlerTestSuite) Test_HandleMessage_MessageIDSet() {
+	data := message.HashiData{
+		ReceiptProof: [][]byte{{1}},
+	}
+
+	prop, err := s.hashiHandler.HandleMessage(message.NewHashiMessage(1, 2, data, "1-2-3"))
+
+	s.Nil(err)
+	s.Equal(prop.MessageID, "1-2-3")
+	s.Equal(prop.Type, message.HashiProposal)
+}
@@ -0,0 +1,73 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+)
+
+type encodedProposal struct {
+	Source      uint8                 `json:"source"`
+	Destination uint8                 `json:"destination"`
+	Type        proposal.ProposalType `json:"type"`
+	MessageID   string                `json:"messageId"`
+	Data        json.RawMessage       `json:"data"`
+}
+
+// MarshalProposals encodes transfer and hashi proposals to JSON
+func MarshalProposals(props []*proposal.Proposal) ([]byte, error) {
+	encodedProps := make([]encodedProposal, len(props))
+	for i, prop := range props {
+		data, err := json.Marshal(prop.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedProps[i] = encodedProposal{
+			Source:      prop.Source,
+			Destination: prop.Destination,
+			Type:        prop.Type,
+			MessageID:   prop.MessageID,
+			Data:        data,
+		}
+	}
+
+	return json.Marshal(encodedProps)
+}
+
+// UnmarshalProposals decodes proposals encoded by MarshalProposals
+func UnmarshalProposals(input []byte) ([]*proposal.Proposal, error) {
+	var encodedProps []encodedProposal
+	err := json.Unmarshal(input, &encodedProps)
+	if err != nil {
+		return nil, err
+	}
+
+	props := make([]*proposal.Proposal, len(encodedProps))
+	for i, encodedProp := range encodedProps {
+		var data interface{}
+		switch encodedProp.Type {
+		case EVMTransferProposal:
+			transferData := TransferData{}
+			err = json.Unmarshal(encodedProp.Data, &transferData)
+			data = transferData
+		case HashiProposal:
+			hashiData := HashiData{}
+			err = json.Unmarshal(encodedProp.Data, &hashiData)
+			data = hashiData
+		default:
+			return nil, fmt.Errorf("unsupported proposal type %s", encodedProp.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		props[i] = proposal.NewProposal(encodedProp.Source, encodedProp.Destination, data, encodedProp.MessageID, encodedProp.Type)
+	}
+
+	return props, nil
+}
@@ -0,0 +1,64 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/events"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+)
+
+type ProposalEncodingTestSuite struct {
+	suite.Suite
+}
+
+func TestRunProposalEncodingTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalEncodingTestSuite))
+}
+
+func (s *ProposalEncodingTestSuite) Test_UnmarshalProposals_InvalidType() {
+	_, err := message.UnmarshalProposals([]byte(`[{"type":"StateRootProposal","data":{}}]`))
+
+	s.NotNil(err)
+}
+
+func (s *ProposalEncodingTestSuite) Test_UnmarshalProposals_Successful() {
+	props := []*proposal.Proposal{
+		proposal.NewProposal(1, 2, message.TransferData{
+			Deposit: &events.Deposit{
+				DestinationDomainID: 2,
+				SecurityModel:       1,
+				ResourceID:          [32]byte{5},
+				DepositNonce:        3,
+				SenderAddress:       common.HexToAddress("0x1"),
+				Data:                []byte{1, 2},
+			},
+			Slot:         big.NewInt(100),
+			AccountProof: []string{"0x01"},
+			StorageProof: []string{"0x02"},
+			Type:         message.FungibleTransfer,
+		}, "transfer", message.EVMTransferProposal),
+		proposal.NewProposal(1, 2, message.HashiData{
+			SrcSlot:           big.NewInt(100),
+			TxSlot:            big.NewInt(90),
+			ReceiptRootProof:  [][]byte{{1}},
+			ReceiptRoot:       [32]byte{2},
+			ReceiptProof:      [][]byte{{3}},
+			TxIndexRLPEncoded: []byte{4},
+			LogIndex:          big.NewInt(5),
+		}, "hashi", message.HashiProposal),
+	}
+
+	encodedProps, err := message.MarshalProposals(props)
+	s.Nil(err)
+	decodedProps, err := message.UnmarshalProposals(encodedProps)
+
+	s.Nil(err)
+	s.Equal(decodedProps, props)
+}
@@ -24,6 +24,9 @@ const (
 type StateRootData struct {
 	StateRoot [32]byte
 	Slot      *big.Int
+	// StartBlock is set when reprocessing a slot and replaces the latest
+	// stored block of the route, which is then left unchanged
+	StartBlock *big.Int
 }
 
 func NewEvmStateRootMessage(source uint8, destination uint8, stateRoot StateRootData, messageID string) *message.Message {
@@ -45,6 +48,10 @@ type BlockStorer interface {
 	LatestBlock(sourceDomainID uint8, destinationDomainID uint8) (*big.Int, error)
 }
 
+type StateRootMetrics interface {
+	TrackStateRootMessage(domainID uint8, sourceDomainID uint8)
+}
+
 type EventHandler interface {
 	HandleEvents(destination uint8, startBlock *big.Int, endBlock *big.Int, slot *big.Int) error
 }
@@ -53,6 +60,7 @@ type StateRootHandler struct {
 	blockFetcher  BlockFetcher
 	blockStorer   BlockStorer
 	eventHandlers []EventHandler
+	metrics       StateRootMetrics
 	startBlock    *big.Int
 	domainID      uint8
 	lock          sync.Mutex
@@ -63,6 +71,7 @@ func NewStateRootHandler(
 	eventHandlers []EventHandler,
 	blockFetcher BlockFetcher,
 	blockStorer BlockStorer,
+	metrics StateRootMetrics,
 	startBlock *big.Int,
 ) *StateRootHandler {
 	return &StateRootHandler{
@@ -71,6 +80,7 @@ func NewStateRootHandler(
 		domainID:      domainID,
 		startBlock:    startBlock,
 		eventHandlers: eventHandlers,
+		metrics:       metrics,
 		lock:          sync.Mutex{},
 	}
 }
@@ -86,20 +96,28 @@ func (h *StateRootHandler) HandleMessage(m *message.Message) (*proposal.Proposal
 		"domainID", m.Destination).Str(
 		"stateRoot", hex.EncodeToString(stateRoot.StateRoot[:]),
 	).Str("messageID", m.ID).Msgf("Received state root message from domain %d", m.Source)
+	h.metrics.TrackStateRootMessage(h.domainID, m.Source)
 	block, err := h.blockFetcher.SignedBeaconBlock(context.Background(), &api.SignedBeaconBlockOpts{
 		Block: stateRoot.Slot.String(),
 	})
 	if err != nil {
 		return nil, err
 	}
-	startBlock, err := h.blockStorer.LatestBlock(h.domainID, m.Source)
+	startBlock := stateRoot.StartBlock
+	if startBlock == nil {
+		startBlock, err = h.blockStorer.LatestBlock(h.domainID, m.Source)
+		if err != nil {
+			return nil, err
+		}
+		if startBlock.Cmp(big.NewInt(0)) == 0 {
+			startBlock = h.startBlock
+		}
+	}
+	blockNumber, err := block.Data.ExecutionBlockNumber()
 	if err != nil {
 		return nil, err
 	}
-	if startBlock.Cmp(big.NewInt(0)) == 0 {
-		startBlock = h.startBlock
-	}
-	endBlock := big.NewInt(int64(block.Data.Deneb.Message.Body.ExecutionPayload.BlockNumber))
+	endBlock := new(big.Int).SetUint64(blockNumber)
 
 	for _, h := range h.eventHandlers {
 		err = h.HandleEvents(m.Source, new(big.Int).Set(startBlock), new(big.Int).Set(endBlock), stateRoot.Slot)
@@ -108,6 +126,12 @@ func (h *StateRootHandler) HandleMessage(m *message.Message) (*proposal.Proposal
 		}
 	}
 
+	if stateRoot.StartBlock != nil {
+		log.Info().Uint8("domainID", h.domainID).Str("messageID", m.ID).Msgf(
+			"Reprocessed slot %s from block %s to block %s", stateRoot.Slot, startBlock, endBlock)
+		return nil, nil
+	}
+
 	err = h.blockStorer.StoreBlock(h.domainID, m.Source, endBlock)
 	if err != nil {
 		log.Err(err).Msgf("Failed saving latest block for %d-%d", h.domainID, m.Source)
@@ -30,6 +30,7 @@ type StateRootHandlerTestSuite struct {
 	mockBlockFetcher   *mock.MockBlockFetcher
 	mockDepositHandler *mock.MockEventHandler
 	mockHashiHandler   *mock.MockEventHandler
+	mockMetrics        *mock.MockStateRootMetrics
 	sourceDomain       uint8
 }
 
@@ -43,6 +44,8 @@ func (s *StateRootHandlerTestSuite) SetupTest() {
 	s.mockBlockStorer = mock.NewMockBlockStorer(ctrl)
 	s.mockDepositHandler = mock.NewMockEventHandler(ctrl)
 	s.mockHashiHandler = mock.NewMockEventHandler(ctrl)
+	s.mockMetrics = mock.NewMockStateRootMetrics(ctrl)
+	s.mockMetrics.EXPECT().TrackStateRootMessage(gomock.Any(), gomock.Any()).AnyTimes()
 	s.msgChan = make(chan []*evmMessage.Message, 10)
 	s.sourceDomain = 1
 	s.stateRootHandler = message.NewStateRootHandler(
@@ -50,6 +53,7 @@ func (s *StateRootHandlerTestSuite) SetupTest() {
 		[]message.EventHandler{s.mockDepositHandler, s.mockHashiHandler},
 		s.mockBlockFetcher,
 		s.mockBlockStorer,
+		s.mockMetrics,
 		big.NewInt(50),
 	)
 }
@@ -71,6 +75,7 @@ func (s *StateRootHandlerTestSuite) Test_HandleEvents_MissingStartBlock() {
 		Block: "1000",
 	}).Return(&api.Response[*spec.VersionedSignedBeaconBlock]{
 		Data: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionDeneb,
 			Deneb: &deneb.SignedBeaconBlock{
 				Message: &deneb.BeaconBlock{
 					Slot: phase0.Slot(1000),
@@ -101,6 +106,7 @@ func (s *StateRootHandlerTestSuite) Test_HandleEvents_ExistingStartBlock() {
 		Block: "1000",
 	}).Return(&api.Response[*spec.VersionedSignedBeaconBlock]{
 		Data: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionDeneb,
 			Deneb: &deneb.SignedBeaconBlock{
 				Message: &deneb.BeaconBlock{
 					Slot: phase0.Slot(1000),
@@ -125,3 +131,33 @@ func (s *StateRootHandlerTestSuite) Test_HandleEvents_ExistingStartBlock() {
 
 	s.Nil(err)
 }
+
+func (s *StateRootHandlerTestSuite) Test_HandleEvents_ReprocessedSlot() {
+	s.mockBlockFetcher.EXPECT().SignedBeaconBlock(context.Background(), &api.SignedBeaconBlockOpts{
+		Block: "1000",
+	}).Return(&api.Response[*spec.VersionedSignedBeaconBlock]{
+		Data: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionDeneb,
+			Deneb: &deneb.SignedBeaconBlock{
+				Message: &deneb.BeaconBlock{
+					Slot: phase0.Slot(1000),
+					Body: &deneb.BeaconBlockBody{
+						ExecutionPayload: &deneb.ExecutionPayload{
+							BlockNumber: 100,
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	s.mockDepositHandler.EXPECT().HandleEvents(uint8(2), big.NewInt(60), big.NewInt(100), big.NewInt(1000)).Return(nil)
+	s.mockHashiHandler.EXPECT().HandleEvents(uint8(2), big.NewInt(60), big.NewInt(100), big.NewInt(1000)).Return(nil)
+
+	_, err := s.stateRootHandler.HandleMessage(message.NewEvmStateRootMessage(2, s.sourceDomain, message.StateRootData{
+		Slot:       big.NewInt(1000),
+		StartBlock: big.NewInt(60),
+	}, "id"))
+
+	s.Nil(err)
+}
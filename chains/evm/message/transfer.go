@@ -47,5 +47,6 @@ func (h *TransferHandler) HandleMessage(m *message.Message) (*proposal.Proposal,
 		Destination: m.Destination,
 		Type:        EVMTransferProposal,
 		Data:        m.Data,
+		MessageID:   m.ID,
 	}, nil
 }
@@ -0,0 +1,40 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package message_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+)
+
+type TransferHandlerTestSuite struct {
+	suite.Suite
+
+	transferHandler *message.TransferHandler
+}
+
+func TestRunTransferHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferHandlerTestSuite))
+}
+
+func (s *TransferHandlerTestSuite) SetupTest() {
+	s.transferHandler = &message.TransferHandler{}
+}
+
+func (s *TransferHandlerTestSuite) Test_HandleMessage_MessageIDSet() {
+	data := message.TransferData{
+		Slot: big.NewInt(100),
+		Type: message.FungibleTransfer,
+	}
+
+	prop, err := s.transferHandler.HandleMessage(message.NewEVMTransferMessage(1, 2, data, "1-2-3"))
+
+	s.Nil(err)
+	s.Equal(prop.MessageID, "1-2-3")
+	s.Equal(prop.Type, message.EVMTransferProposal)
+	s.Equal(prop.Data, data)
+}
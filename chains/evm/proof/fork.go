@@ -0,0 +1,214 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	ssz "github.com/ferranbt/fastssz"
+	gnosisDeneb "github.com/mpetrun5/go-eth2-client/spec/deneb"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof/gnosis"
+)
+
+// forkGindices contains generalized indices of proven fields that can move between forks
+type forkGindices struct {
+	// receiptsRoot is the gindex of the execution payload receipts root in the beacon block
+	receiptsRoot int64
+	// blockRoots is the gindex of block roots in the beacon state
+	blockRoots int64
+	// historicalSummaries is the gindex of historical summaries in the beacon state
+	historicalSummaries int64
+}
+
+// gindicesByFork contains gindices of every supported fork. New forks are supported by adding
+// their gindices here and their SSZ containers to decodeState and decodeBlock.
+var gindicesByFork = map[spec.DataVersion]forkGindices{
+	spec.DataVersionCapella: {
+		receiptsRoot:        CAPELLA_RECEIPTS_ROOT_GINDEX,
+		blockRoots:          BLOCK_ROOTS_GINDEX,
+		historicalSummaries: HISTORICAL_SUMMARIES_GINDEX,
+	},
+	spec.DataVersionDeneb: {
+		receiptsRoot:        RECEIPTS_ROOT_GINDEX,
+		blockRoots:          BLOCK_ROOTS_GINDEX,
+		historicalSummaries: HISTORICAL_SUMMARIES_GINDEX,
+	},
+	spec.DataVersionElectra: {
+		receiptsRoot:        RECEIPTS_ROOT_GINDEX,
+		blockRoots:          ELECTRA_BLOCK_ROOTS_GINDEX,
+		historicalSummaries: ELECTRA_HISTORICAL_SUMMARIES_GINDEX,
+	},
+}
+
+func gindices(version spec.DataVersion) (forkGindices, error) {
+	gindices, ok := gindicesByFork[version]
+	if !ok {
+		return forkGindices{}, fmt.Errorf("unsupported beacon fork %s", version)
+	}
+
+	return gindices, nil
+}
+
+// forkVersion returns the fork version active at the given slot according to the chain spec
+func (p *ReceiptRootProver) forkVersion(slot *big.Int) (spec.DataVersion, error) {
+	fork := p.spec.Fork(slot.Uint64())
+	var version spec.DataVersion
+	err := version.UnmarshalJSON([]byte(strconv.Quote(fork)))
+	if err != nil {
+		return spec.DataVersionUnknown, fmt.Errorf("unsupported beacon fork %q at slot %s", fork, slot)
+	}
+
+	return version, nil
+}
+
+// sszContainer is a decoded SSZ container proofs are generated from
+type sszContainer interface {
+	GetTree() (*ssz.Node, error)
+}
+
+// versionedState is a beacon state decoded into the SSZ container of the chain preset
+type versionedState struct {
+	version             spec.DataVersion
+	slot                uint64
+	state               sszContainer
+	historicalSummaries int
+}
+
+// versionedBlock is a beacon block decoded into the SSZ container of the chain preset
+type versionedBlock struct {
+	version spec.DataVersion
+	block   sszContainer
+}
+
+// decodeState unmarshals the SSZ encoded beacon state into the state container
+// of the fork and chain preset
+func (p *ReceiptRootProver) decodeState(data *beacon.SSZResponse) (*versionedState, error) {
+	state := &versionedState{
+		version: data.Version,
+	}
+	switch {
+	case data.Version == spec.DataVersionCapella && p.spec.Preset == config.GnosisPreset:
+		capellaState := &gnosis.CapellaBeaconState{}
+		err := capellaState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = capellaState
+		state.slot = uint64(capellaState.Slot)
+		state.historicalSummaries = len(capellaState.HistoricalSummaries)
+	case data.Version == spec.DataVersionCapella:
+		capellaState := &capella.BeaconState{}
+		err := capellaState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = capellaState
+		state.slot = uint64(capellaState.Slot)
+		state.historicalSummaries = len(capellaState.HistoricalSummaries)
+	case data.Version == spec.DataVersionDeneb && p.spec.Preset == config.GnosisPreset:
+		denebState := &gnosisDeneb.BeaconState{}
+		err := denebState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = denebState
+		state.slot = uint64(denebState.Slot)
+		state.historicalSummaries = len(denebState.HistoricalSummaries)
+	case data.Version == spec.DataVersionDeneb:
+		denebState := &deneb.BeaconState{}
+		err := denebState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = denebState
+		state.slot = uint64(denebState.Slot)
+		state.historicalSummaries = len(denebState.HistoricalSummaries)
+	case data.Version == spec.DataVersionElectra && p.spec.Preset == config.GnosisPreset:
+		electraState := &gnosis.ElectraBeaconState{}
+		err := electraState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = electraState
+		state.slot = uint64(electraState.Slot)
+		state.historicalSummaries = len(electraState.HistoricalSummaries)
+	case data.Version == spec.DataVersionElectra:
+		electraState := &electra.BeaconState{}
+		err := electraState.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		state.state = electraState
+		state.slot = uint64(electraState.Slot)
+		state.historicalSummaries = len(electraState.HistoricalSummaries)
+	default:
+		return nil, fmt.Errorf("unsupported beacon fork %s for preset %s", data.Version, p.spec.Preset)
+	}
+
+	return state, nil
+}
+
+// decodeBlock unmarshals the SSZ encoded signed beacon block into the block container
+// of the fork and chain preset
+func (p *ReceiptRootProver) decodeBlock(data *beacon.SSZResponse) (*versionedBlock, error) {
+	block := &versionedBlock{
+		version: data.Version,
+	}
+	switch {
+	case data.Version == spec.DataVersionCapella && p.spec.Preset == config.GnosisPreset:
+		capellaBlock := &gnosis.CapellaSignedBeaconBlock{}
+		err := capellaBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = capellaBlock.Message
+	case data.Version == spec.DataVersionCapella:
+		capellaBlock := &capella.SignedBeaconBlock{}
+		err := capellaBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = capellaBlock.Message
+	case data.Version == spec.DataVersionDeneb && p.spec.Preset == config.GnosisPreset:
+		denebBlock := &gnosisDeneb.SignedBeaconBlock{}
+		err := denebBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = denebBlock.Message
+	case data.Version == spec.DataVersionDeneb:
+		denebBlock := &deneb.SignedBeaconBlock{}
+		err := denebBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = denebBlock.Message
+	case data.Version == spec.DataVersionElectra && p.spec.Preset == config.GnosisPreset:
+		electraBlock := &gnosis.ElectraSignedBeaconBlock{}
+		err := electraBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = electraBlock.Message
+	case data.Version == spec.DataVersionElectra:
+		electraBlock := &electra.SignedBeaconBlock{}
+		err := electraBlock.UnmarshalSSZ(data.Data)
+		if err != nil {
+			return nil, err
+		}
+		block.block = electraBlock.Message
+	default:
+		return nil, fmt.Errorf("unsupported beacon fork %s for preset %s", data.Version, p.spec.Preset)
+	}
+
+	return block, nil
+}
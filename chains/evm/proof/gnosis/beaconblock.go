@@ -0,0 +1,74 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package gnosis
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ElectraSignedBeaconBlock is the signed Electra beacon block with the Gnosis preset
+type ElectraSignedBeaconBlock struct {
+	Message   *ElectraBeaconBlock
+	Signature phase0.BLSSignature `ssz-size:"96"`
+}
+
+// ElectraBeaconBlock is the Electra beacon block with the Gnosis preset
+type ElectraBeaconBlock struct {
+	Slot          phase0.Slot
+	ProposerIndex phase0.ValidatorIndex
+	ParentRoot    phase0.Root `ssz-size:"32"`
+	StateRoot     phase0.Root `ssz-size:"32"`
+	Body          *ElectraBeaconBlockBody
+}
+
+// ElectraBeaconBlockBody is the Electra beacon block body with the Gnosis preset
+type ElectraBeaconBlockBody struct {
+	RANDAOReveal          phase0.BLSSignature `ssz-size:"96"`
+	ETH1Data              *phase0.ETH1Data
+	Graffiti              [32]byte                      `ssz-size:"32"`
+	ProposerSlashings     []*phase0.ProposerSlashing    `ssz-max:"16"`
+	AttesterSlashings     []*electra.AttesterSlashing   `ssz-max:"1"`
+	Attestations          []*electra.Attestation        `ssz-max:"8"`
+	Deposits              []*phase0.Deposit             `ssz-max:"16"`
+	VoluntaryExits        []*phase0.SignedVoluntaryExit `ssz-max:"16"`
+	SyncAggregate         *altair.SyncAggregate
+	ExecutionPayload      *ElectraExecutionPayload
+	BLSToExecutionChanges []*capella.SignedBLSToExecutionChange `ssz-max:"16"`
+	BlobKZGCommitments    []deneb.KZGCommitment                 `ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *electra.ExecutionRequests
+}
+
+// CapellaSignedBeaconBlock is the signed Capella beacon block with the Gnosis preset
+type CapellaSignedBeaconBlock struct {
+	Message   *CapellaBeaconBlock
+	Signature phase0.BLSSignature `ssz-size:"96"`
+}
+
+// CapellaBeaconBlock is the Capella beacon block with the Gnosis preset
+type CapellaBeaconBlock struct {
+	Slot          phase0.Slot
+	ProposerIndex phase0.ValidatorIndex
+	ParentRoot    phase0.Root `ssz-size:"32"`
+	StateRoot     phase0.Root `ssz-size:"32"`
+	Body          *CapellaBeaconBlockBody
+}
+
+// CapellaBeaconBlockBody is the Capella beacon block body with the Gnosis preset
+type CapellaBeaconBlockBody struct {
+	RANDAOReveal          phase0.BLSSignature `ssz-size:"96"`
+	ETH1Data              *phase0.ETH1Data
+	Graffiti              [32]byte                      `ssz-size:"32"`
+	ProposerSlashings     []*phase0.ProposerSlashing    `ssz-max:"16"`
+	AttesterSlashings     []*phase0.AttesterSlashing    `ssz-max:"2"`
+	Attestations          []*phase0.Attestation         `ssz-max:"128"`
+	Deposits              []*phase0.Deposit             `ssz-max:"16"`
+	VoluntaryExits        []*phase0.SignedVoluntaryExit `ssz-max:"16"`
+	SyncAggregate         *altair.SyncAggregate
+	ExecutionPayload      *CapellaExecutionPayload
+	BLSToExecutionChanges []*capella.SignedBLSToExecutionChange `ssz-max:"16"`
+}
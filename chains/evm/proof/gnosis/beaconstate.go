@@ -0,0 +1,86 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package gnosis
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// ElectraBeaconState is the Electra beacon state with the Gnosis preset
+type ElectraBeaconState struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         phase0.Root `ssz-size:"32"`
+	Slot                          phase0.Slot
+	Fork                          *phase0.Fork
+	LatestBlockHeader             *phase0.BeaconBlockHeader
+	BlockRoots                    []phase0.Root `ssz-size:"8192,32"`
+	StateRoots                    []phase0.Root `ssz-size:"8192,32"`
+	HistoricalRoots               []phase0.Root `ssz-max:"16777216" ssz-size:"?,32"`
+	ETH1Data                      *phase0.ETH1Data
+	ETH1DataVotes                 []*phase0.ETH1Data `ssz-max:"1024"`
+	ETH1DepositIndex              uint64
+	Validators                    []*phase0.Validator         `ssz-max:"1099511627776"`
+	Balances                      []phase0.Gwei               `ssz-max:"1099511627776"`
+	RANDAOMixes                   []phase0.Root               `ssz-size:"65536,32"`
+	Slashings                     []phase0.Gwei               `ssz-size:"8192"`
+	PreviousEpochParticipation    []altair.ParticipationFlags `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []altair.ParticipationFlags `ssz-max:"1099511627776"`
+	JustificationBits             bitfield.Bitvector4         `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *phase0.Checkpoint
+	CurrentJustifiedCheckpoint    *phase0.Checkpoint
+	FinalizedCheckpoint           *phase0.Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *altair.SyncCommittee
+	NextSyncCommittee             *altair.SyncCommittee
+	LatestExecutionPayloadHeader  *deneb.ExecutionPayloadHeader
+	NextWithdrawalIndex           capella.WithdrawalIndex
+	NextWithdrawalValidatorIndex  phase0.ValidatorIndex
+	HistoricalSummaries           []*capella.HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       phase0.Gwei
+	ExitBalanceToConsume          phase0.Gwei
+	EarliestExitEpoch             phase0.Epoch
+	ConsolidationBalanceToConsume phase0.Gwei
+	EarliestConsolidationEpoch    phase0.Epoch
+	PendingDeposits               []*electra.PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*electra.PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*electra.PendingConsolidation     `ssz-max:"262144"`
+}
+
+// CapellaBeaconState is the Capella beacon state with the Gnosis preset
+type CapellaBeaconState struct {
+	GenesisTime                  uint64
+	GenesisValidatorsRoot        phase0.Root `ssz-size:"32"`
+	Slot                         phase0.Slot
+	Fork                         *phase0.Fork
+	LatestBlockHeader            *phase0.BeaconBlockHeader
+	BlockRoots                   []phase0.Root `ssz-size:"8192,32"`
+	StateRoots                   []phase0.Root `ssz-size:"8192,32"`
+	HistoricalRoots              []phase0.Root `ssz-max:"16777216" ssz-size:"?,32"`
+	ETH1Data                     *phase0.ETH1Data
+	ETH1DataVotes                []*phase0.ETH1Data `ssz-max:"1024"`
+	ETH1DepositIndex             uint64
+	Validators                   []*phase0.Validator         `ssz-max:"1099511627776"`
+	Balances                     []phase0.Gwei               `ssz-max:"1099511627776"`
+	RANDAOMixes                  []phase0.Root               `ssz-size:"65536,32"`
+	Slashings                    []phase0.Gwei               `ssz-size:"8192"`
+	PreviousEpochParticipation   []altair.ParticipationFlags `ssz-max:"1099511627776"`
+	CurrentEpochParticipation    []altair.ParticipationFlags `ssz-max:"1099511627776"`
+	JustificationBits            bitfield.Bitvector4         `ssz-size:"1"`
+	PreviousJustifiedCheckpoint  *phase0.Checkpoint
+	CurrentJustifiedCheckpoint   *phase0.Checkpoint
+	FinalizedCheckpoint          *phase0.Checkpoint
+	InactivityScores             []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee         *altair.SyncCommittee
+	NextSyncCommittee            *altair.SyncCommittee
+	LatestExecutionPayloadHeader *capella.ExecutionPayloadHeader
+	NextWithdrawalIndex          capella.WithdrawalIndex
+	NextWithdrawalValidatorIndex phase0.ValidatorIndex
+	HistoricalSummaries          []*capella.HistoricalSummary `ssz-max:"16777216"`
+}
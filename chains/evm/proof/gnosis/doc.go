@@ -0,0 +1,7 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package gnosis contains Capella and Electra beacon state and block containers with the Gnosis preset.
+// The SSZ encoding is the upstream generated encoding with the Gnosis ETH1 data votes
+// limit of 1024 and withdrawals limit of 8, which change the trees proofs are generated from.
+package gnosis
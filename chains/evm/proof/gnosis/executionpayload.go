@@ -0,0 +1,51 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package gnosis
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// ElectraExecutionPayload is the Electra execution payload with the Gnosis preset
+type ElectraExecutionPayload struct {
+	ParentHash    phase0.Hash32              `ssz-size:"32"`
+	FeeRecipient  bellatrix.ExecutionAddress `ssz-size:"20"`
+	StateRoot     phase0.Root                `ssz-size:"32"`
+	ReceiptsRoot  phase0.Root                `ssz-size:"32"`
+	LogsBloom     [256]byte                  `ssz-size:"256"`
+	PrevRandao    [32]byte                   `ssz-size:"32"`
+	BlockNumber   uint64
+	GasLimit      uint64
+	GasUsed       uint64
+	Timestamp     uint64
+	ExtraData     []byte                  `ssz-max:"32"`
+	BaseFeePerGas *uint256.Int            `ssz-size:"32"`
+	BlockHash     phase0.Hash32           `ssz-size:"32"`
+	Transactions  []bellatrix.Transaction `ssz-max:"1048576,1073741824" ssz-size:"?,?"`
+	Withdrawals   []*capella.Withdrawal   `ssz-max:"8"`
+	BlobGasUsed   uint64
+	ExcessBlobGas uint64
+}
+
+// CapellaExecutionPayload is the Capella execution payload with the Gnosis preset
+type CapellaExecutionPayload struct {
+	ParentHash    phase0.Hash32              `ssz-size:"32"`
+	FeeRecipient  bellatrix.ExecutionAddress `ssz-size:"20"`
+	StateRoot     [32]byte                   `ssz-size:"32"`
+	ReceiptsRoot  [32]byte                   `ssz-size:"32"`
+	LogsBloom     [256]byte                  `ssz-size:"256"`
+	PrevRandao    [32]byte                   `ssz-size:"32"`
+	BlockNumber   uint64
+	GasLimit      uint64
+	GasUsed       uint64
+	Timestamp     uint64
+	ExtraData     []byte                  `ssz-max:"32"`
+	BaseFeePerGas [32]byte                `ssz-size:"32"`
+	BlockHash     phase0.Hash32           `ssz-size:"32"`
+	Transactions  []bellatrix.Transaction `ssz-max:"1048576,1073741824" ssz-size:"?,?"`
+	Withdrawals   []*capella.Withdrawal   `ssz-max:"8"`
+}
@@ -4,29 +4,60 @@
 package proof
 
 import (
-	"bytes"
 	"context"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ethereum/go-ethereum/trie/trienode"
+	"github.com/rs/zerolog/log"
 )
 
+const RECEIPT_TRIE_CACHE_SIZE = 64
+
 type TransactionFetcher interface {
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	CallContext(ctx context.Context, target interface{}, rpcMethod string, args ...interface{}) error
+}
+
+// ReceiptsRootMismatchError is returned when the root of the receipt trie rebuilt from
+// the block receipts differs from the receipts root in the block header
+type ReceiptsRootMismatchError struct {
+	BlockHash    common.Hash
+	ReceiptsRoot common.Hash
+	TrieRoot     common.Hash
+}
+
+func (e *ReceiptsRootMismatchError) Error() string {
+	return fmt.Sprintf(
+		"receipt trie root %s does not match receipts root %s of block %s", e.TrieRoot, e.ReceiptsRoot, e.BlockHash)
+}
+
+type ReceiptProverMetrics interface {
+	TrackReceiptTrieCacheHit()
+	TrackReceiptTrieCacheMiss()
 }
 
 type ReceiptProver struct {
 	txFetcher TransactionFetcher
+	metrics   ReceiptProverMetrics
+	trieCache *lru.Cache[common.Hash, *trie.Trie]
 }
 
-func NewReceiptProver(txFetcher TransactionFetcher) *ReceiptProver {
+func NewReceiptProver(txFetcher TransactionFetcher, metrics ReceiptProverMetrics) *ReceiptProver {
 	return &ReceiptProver{
 		txFetcher: txFetcher,
+		metrics:   metrics,
+		trieCache: lru.NewCache[common.Hash, *trie.Trie](RECEIPT_TRIE_CACHE_SIZE),
 	}
 }
 
@@ -38,12 +69,7 @@ func (p *ReceiptProver) ReceiptProof(txHash common.Hash) ([][]byte, error) {
 		return nil, err
 	}
 
-	siblings, err := p.siblings(receipt.BlockHash)
-	if err != nil {
-		return nil, err
-	}
-
-	trie, err := p.trie(siblings)
+	trie, err := p.blockTrie(receipt.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +92,77 @@ func (p *ReceiptProver) ReceiptProof(txHash common.Hash) ([][]byte, error) {
 	return proof, nil
 }
 
+// VerifyReceiptProof verifies the receipt proof against the receipts root of the block
+// and returns the log at the given index of the proven receipt.
+func (p *ReceiptProver) VerifyReceiptProof(
+	receiptsRoot common.Hash,
+	txIndexRLP []byte,
+	proof [][]byte,
+	logIndex *big.Int,
+) (*types.Log, error) {
+	proofDB := memorydb.New()
+	for _, node := range proof {
+		err := proofDB.Put(crypto.Keccak256(node), node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := trie.VerifyProof(receiptsRoot, txIndexRLP, proofDB)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("receipt missing from trie with root %s", receiptsRoot)
+	}
+
+	receipt := &types.Receipt{}
+	err = receipt.UnmarshalBinary(value)
+	if err != nil {
+		return nil, err
+	}
+	if !logIndex.IsInt64() || logIndex.Sign() < 0 || logIndex.Int64() >= int64(len(receipt.Logs)) {
+		return nil, fmt.Errorf("log index %s out of range for receipt with %d logs", logIndex, len(receipt.Logs))
+	}
+
+	return receipt.Logs[logIndex.Int64()], nil
+}
+
+// blockTrie returns the receipt trie of the block, building it from the block receipts
+// and checking its root against the block header if it is not already cached
+func (p *ReceiptProver) blockTrie(blockHash common.Hash) (*trie.Trie, error) {
+	cachedTrie, ok := p.trieCache.Get(blockHash)
+	if ok {
+		p.metrics.TrackReceiptTrieCacheHit()
+		return cachedTrie, nil
+	}
+	p.metrics.TrackReceiptTrieCacheMiss()
+
+	siblings, err := p.siblings(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	trie, err := p.trie(siblings)
+	if err != nil {
+		return nil, err
+	}
+	header, err := p.txFetcher.HeaderByHash(context.Background(), blockHash)
+	if err != nil {
+		return nil, err
+	}
+	trieRoot := trie.Hash()
+	if trieRoot != header.ReceiptHash {
+		return nil, &ReceiptsRootMismatchError{
+			BlockHash:    blockHash,
+			ReceiptsRoot: header.ReceiptHash,
+			TrieRoot:     trieRoot,
+		}
+	}
+
+	p.trieCache.Add(blockHash, trie)
+	return trie, nil
+}
+
 func (p *ReceiptProver) trie(siblings []*types.Receipt) (*trie.Trie, error) {
 	memDB := rawdb.NewMemoryDatabase()
 	db := trie.NewDatabase(memDB, nil)
@@ -80,29 +177,45 @@ func (p *ReceiptProver) trie(siblings []*types.Receipt) (*trie.Trie, error) {
 			return nil, err
 		}
 
-		var buffer bytes.Buffer
-		err = sibling.EncodeRLP(&buffer)
+		value, err := sibling.MarshalBinary()
 		if err != nil {
 			return nil, err
 		}
-
-		if sibling.Type == 0 {
-			err = trie.Update(key, buffer.Bytes())
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			err = trie.Update(key, buffer.Bytes()[3:])
-			if err != nil {
-				return nil, err
-			}
+		err = trie.Update(key, value)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return trie, nil
 }
 
+// siblings fetches all receipts of the block with eth_getBlockReceipts and falls back
+// to fetching receipts per transaction if the endpoint does not support it.
 func (p *ReceiptProver) siblings(blockHash common.Hash) ([]*types.Receipt, error) {
+	siblings, err := p.blockReceipts(blockHash)
+	if err == nil {
+		return siblings, nil
+	}
+
+	log.Debug().Err(err).Msgf("Failed fetching receipts of block %s, fetching receipts per transaction", blockHash)
+	return p.transactionReceipts(blockHash)
+}
+
+func (p *ReceiptProver) blockReceipts(blockHash common.Hash) ([]*types.Receipt, error) {
+	var receipts []*types.Receipt
+	err := p.txFetcher.CallContext(context.Background(), &receipts, "eth_getBlockReceipts", blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) == 0 {
+		return nil, fmt.Errorf("no receipts returned for block %s", blockHash)
+	}
+
+	return receipts, nil
+}
+
+func (p *ReceiptProver) transactionReceipts(blockHash common.Hash) ([]*types.Receipt, error) {
 	block, err := p.txFetcher.BlockByHash(context.Background(), blockHash)
 	if err != nil {
 		return nil, err
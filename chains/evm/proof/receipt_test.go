@@ -4,13 +4,18 @@
 package proof_test
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"os"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/suite"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
@@ -18,11 +23,14 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+var receiptsRoot = common.HexToHash("0xa50beb98844c8fdc2315f04b7b34389cbbd8d6af1fdbe7486541fd72dc783f63")
+
 type ReceiptProofTestSuite struct {
 	suite.Suite
 
 	prover    *proof.ReceiptProver
 	txFetcher *mock.MockTransactionFetcher
+	metrics   *mock.MockReceiptProverMetrics
 	receipt   *types.Receipt
 }
 
@@ -33,6 +41,8 @@ func TestRunReceiptProofTestSuite(t *testing.T) {
 func (s *ReceiptProofTestSuite) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	s.txFetcher = mock.NewMockTransactionFetcher(ctrl)
+	s.metrics = mock.NewMockReceiptProverMetrics(ctrl)
+	s.metrics.EXPECT().TrackReceiptTrieCacheMiss()
 
 	transactions := []*types.Transaction{}
 	transactionBytes, err := os.ReadFile("./stubs/transactions.json")
@@ -49,7 +59,9 @@ func (s *ReceiptProofTestSuite) SetupTest() {
 		[]*types.Header{},
 		[]*types.Receipt{},
 		trie.NewStackTrie(nil))
+	s.txFetcher.EXPECT().CallContext(gomock.Any(), gomock.Any(), "eth_getBlockReceipts", gomock.Any()).Return(fmt.Errorf("method not found"))
 	s.txFetcher.EXPECT().BlockByHash(gomock.Any(), gomock.Any()).Return(block, nil)
+	s.txFetcher.EXPECT().HeaderByHash(gomock.Any(), gomock.Any()).Return(&types.Header{ReceiptHash: receiptsRoot}, nil)
 
 	siblings := []*types.Receipt{}
 	siblingBytes, err := os.ReadFile("./stubs/siblings.json")
@@ -81,7 +93,7 @@ func (s *ReceiptProofTestSuite) SetupTest() {
 	}
 	s.receipt = receipt
 	s.txFetcher.EXPECT().TransactionReceipt(gomock.Any(), common.HexToHash("0xf3775a2e822ed9a51ddf1c0e75a9d4e258bafb9f1005f784a29dd22d9a9e4c72")).Return(receipt, nil)
-	s.prover = proof.NewReceiptProver(s.txFetcher)
+	s.prover = proof.NewReceiptProver(s.txFetcher, s.metrics)
 }
 
 func (s *ReceiptProofTestSuite) Test_ReceiptProof() {
@@ -97,3 +109,116 @@ func (s *ReceiptProofTestSuite) Test_ReceiptProof() {
 		s.Equal(expectedProof[i], hex.EncodeToString(leaf))
 	}
 }
+
+func (s *ReceiptProofTestSuite) Test_VerifyReceiptProof_InvalidRoot() {
+	p, err := s.prover.ReceiptProof(common.HexToHash("0xf3775a2e822ed9a51ddf1c0e75a9d4e258bafb9f1005f784a29dd22d9a9e4c72"))
+	s.Nil(err)
+	key, _ := rlp.EncodeToBytes(s.receipt.TransactionIndex)
+
+	_, err = s.prover.VerifyReceiptProof(common.HexToHash("0x7b2954b1bbe6308967f1dad775f0507bee594d3ce44a7eda2ed1b6bcdb7c7b8d"), key, p, big.NewInt(0))
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofTestSuite) Test_VerifyReceiptProof_InvalidLogIndex() {
+	p, err := s.prover.ReceiptProof(common.HexToHash("0xf3775a2e822ed9a51ddf1c0e75a9d4e258bafb9f1005f784a29dd22d9a9e4c72"))
+	s.Nil(err)
+	key, _ := rlp.EncodeToBytes(s.receipt.TransactionIndex)
+
+	_, err = s.prover.VerifyReceiptProof(crypto.Keccak256Hash(p[0]), key, p, big.NewInt(1))
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofTestSuite) Test_VerifyReceiptProof_ValidProof() {
+	p, err := s.prover.ReceiptProof(common.HexToHash("0xf3775a2e822ed9a51ddf1c0e75a9d4e258bafb9f1005f784a29dd22d9a9e4c72"))
+	s.Nil(err)
+	key, _ := rlp.EncodeToBytes(s.receipt.TransactionIndex)
+
+	l, err := s.prover.VerifyReceiptProof(crypto.Keccak256Hash(p[0]), key, p, big.NewInt(0))
+
+	s.Nil(err)
+	s.Equal(l.Address, s.receipt.Logs[0].Address)
+	s.Equal(l.Topics, s.receipt.Logs[0].Topics)
+	s.Equal(l.Data, s.receipt.Logs[0].Data)
+}
+
+type ReceiptProofBlockReceiptsTestSuite struct {
+	suite.Suite
+
+	prover    *proof.ReceiptProver
+	txFetcher *mock.MockTransactionFetcher
+	metrics   *mock.MockReceiptProverMetrics
+	receipt   *types.Receipt
+}
+
+func TestRunReceiptProofBlockReceiptsTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptProofBlockReceiptsTestSuite))
+}
+
+func (s *ReceiptProofBlockReceiptsTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.txFetcher = mock.NewMockTransactionFetcher(ctrl)
+	s.metrics = mock.NewMockReceiptProverMetrics(ctrl)
+	s.metrics.EXPECT().TrackReceiptTrieCacheMiss()
+
+	siblingBytes, err := os.ReadFile("./stubs/siblings.json")
+	if err != nil {
+		panic(err)
+	}
+	s.txFetcher.EXPECT().CallContext(gomock.Any(), gomock.Any(), "eth_getBlockReceipts", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, target interface{}, rpcMethod string, args ...interface{}) error {
+			return json.Unmarshal(siblingBytes, target)
+		})
+
+	receipt := &types.Receipt{}
+	receiptBytes, err := os.ReadFile("./stubs/receipt.json")
+	if err != nil {
+		panic(err)
+	}
+	err = receipt.UnmarshalJSON(receiptBytes)
+	if err != nil {
+		panic(err)
+	}
+	s.receipt = receipt
+	s.txFetcher.EXPECT().TransactionReceipt(gomock.Any(), receipt.TxHash).Return(receipt, nil)
+	s.prover = proof.NewReceiptProver(s.txFetcher, s.metrics)
+}
+
+func (s *ReceiptProofBlockReceiptsTestSuite) Test_ReceiptProof_ValidProof() {
+	s.txFetcher.EXPECT().HeaderByHash(gomock.Any(), s.receipt.BlockHash).Return(&types.Header{ReceiptHash: receiptsRoot}, nil)
+
+	p, err := s.prover.ReceiptProof(s.receipt.TxHash)
+	s.Nil(err)
+	key, _ := rlp.EncodeToBytes(s.receipt.TransactionIndex)
+
+	l, err := s.prover.VerifyReceiptProof(crypto.Keccak256Hash(p[0]), key, p, big.NewInt(0))
+
+	s.Nil(err)
+	s.Equal(l.Address, s.receipt.Logs[0].Address)
+	s.Equal(l.Topics, s.receipt.Logs[0].Topics)
+	s.Equal(l.Data, s.receipt.Logs[0].Data)
+}
+
+func (s *ReceiptProofBlockReceiptsTestSuite) Test_ReceiptProof_CachedTrie() {
+	s.txFetcher.EXPECT().HeaderByHash(gomock.Any(), s.receipt.BlockHash).Return(&types.Header{ReceiptHash: receiptsRoot}, nil)
+	s.txFetcher.EXPECT().TransactionReceipt(gomock.Any(), s.receipt.TxHash).Return(s.receipt, nil)
+	s.metrics.EXPECT().TrackReceiptTrieCacheHit()
+
+	p1, err := s.prover.ReceiptProof(s.receipt.TxHash)
+	s.Nil(err)
+	p2, err := s.prover.ReceiptProof(s.receipt.TxHash)
+
+	s.Nil(err)
+	s.Equal(p1, p2)
+}
+
+func (s *ReceiptProofBlockReceiptsTestSuite) Test_ReceiptProof_ReceiptsRootMismatch() {
+	s.txFetcher.EXPECT().HeaderByHash(gomock.Any(), s.receipt.BlockHash).Return(&types.Header{ReceiptHash: types.EmptyReceiptsHash}, nil)
+
+	_, err := s.prover.ReceiptProof(s.receipt.TxHash)
+
+	mismatchErr := &proof.ReceiptsRootMismatchError{}
+	s.ErrorAs(err, &mismatchErr)
+	s.Equal(mismatchErr.TrieRoot, receiptsRoot)
+}
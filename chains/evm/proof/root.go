@@ -5,36 +5,41 @@ package proof
 
 import (
 	"context"
+	"fmt"
 	"math/big"
-	"strconv"
+	"math/bits"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
-	"github.com/attestantio/go-eth2-client/spec"
-	"github.com/attestantio/go-eth2-client/spec/deneb"
 	ssz "github.com/ferranbt/fastssz"
-	gnosisDeneb "github.com/mpetrun5/go-eth2-client/spec/deneb"
 	cache "github.com/patrickmn/go-cache"
 	"github.com/rs/zerolog/log"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
 )
 
 const (
-	BEACON_STATE_GINDEX              = 11
-	RECEIPTS_ROOT_GINDEX             = 6435
-	BLOCK_ROOTS_GINDEX         int64 = 37
-	SLOTS_PER_HISTORICAL_LIMIT       = 8192
+	BEACON_STATE_GINDEX                       = 11
+	RECEIPTS_ROOT_GINDEX                int64 = 6435
+	CAPELLA_RECEIPTS_ROOT_GINDEX        int64 = 3219
+	BLOCK_ROOTS_GINDEX                  int64 = 37
+	ELECTRA_BLOCK_ROOTS_GINDEX          int64 = 69
+	HISTORICAL_SUMMARIES_GINDEX         int64 = 59
+	ELECTRA_HISTORICAL_SUMMARIES_GINDEX int64 = 91
+	BLOCK_SUMMARY_ROOT_GINDEX           int64 = 2
+	LIST_DATA_GINDEX                    int64 = 2
+	HISTORICAL_SUMMARIES_LIMIT                = 16777216
 )
 
 type BeaconStateFetcher interface {
-	BeaconState(
-		ctx context.Context,
-		opts *api.BeaconStateOpts,
-	) (
-		*api.Response[*spec.VersionedBeaconState],
-		error,
-	)
+	BeaconStateSSZ(ctx context.Context, stateID string) (*beacon.SSZResponse, error)
+}
+
+type BeaconStateStore interface {
+	StoreState(slot *big.Int, state *beacon.SSZResponse) error
+	State(slot *big.Int) (*beacon.SSZResponse, error)
+	DeleteState(slot *big.Int) error
 }
 
 type BeaconClient interface {
@@ -45,62 +50,184 @@ type BeaconClient interface {
 		*api.Response[*apiv1.BeaconBlockHeader],
 		error,
 	)
-	SignedBeaconBlock(ctx context.Context,
-		opts *api.SignedBeaconBlockOpts,
-	) (
-		*api.Response[*spec.VersionedSignedBeaconBlock],
-		error,
-	)
+	SignedBeaconBlockSSZ(ctx context.Context, blockID string) (*beacon.SSZResponse, error)
 }
 
 type ReceiptRootProver struct {
 	beaconClient       BeaconClient
 	beaconStateFetcher BeaconStateFetcher
-	spec               config.Spec
+	spec               *config.ChainSpec
 	stateCache         *cache.Cache
+	stateStore         BeaconStateStore
 }
 
-func NewReceiptRootProver(beaconClient BeaconClient, beaconStateFetcher BeaconStateFetcher, spec config.Spec) *ReceiptRootProver {
+// NewReceiptRootProver creates the receipt root prover. The state store is optional and
+// persists fetched beacon states across restarts if set.
+func NewReceiptRootProver(
+	beaconClient BeaconClient,
+	beaconStateFetcher BeaconStateFetcher,
+	spec *config.ChainSpec,
+	stateStore BeaconStateStore,
+) *ReceiptRootProver {
 	return &ReceiptRootProver{
 		beaconClient:       beaconClient,
 		beaconStateFetcher: beaconStateFetcher,
 		spec:               spec,
 		stateCache:         cache.New(time.Minute*5, time.Minute*5),
+		stateStore:         stateStore,
 	}
 }
 
 // ReceiptRootProof returns the prove from the beacon block root to the receipt roof of the given slot.
 // The path for the proof is beacon block -> beacon state -> block roots -> execution payload header -> receipt root.
+// If the target slot is no longer in the block roots of the current state, the path goes through
+// historical summaries instead: beacon block -> beacon state -> historical summaries -> block summary root ->
+// block roots -> execution payload header -> receipt root.
 func (p *ReceiptRootProver) ReceiptsRootProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) ([][]byte, error) {
+	if targetSlot.Cmp(currentSlot) > 0 {
+		return nil, fmt.Errorf("target slot %s is after current slot %s", targetSlot, currentSlot)
+	}
+
 	receiptsRootProof, err := p.receiptsRootProof(ctx, targetSlot)
 	if err != nil {
 		return nil, err
 	}
 
-	if currentSlot.Cmp(targetSlot) != 0 {
-		beaconStateProof, err := p.historicalRootProof(ctx, currentSlot, targetSlot)
-		if err != nil {
-			return nil, err
-		}
+	if currentSlot.Cmp(targetSlot) == 0 {
+		return receiptsRootProof, nil
+	}
 
-		return append(receiptsRootProof, beaconStateProof...), nil
+	var beaconStateProof [][]byte
+	if p.isInBlockRoots(currentSlot, targetSlot) {
+		beaconStateProof, err = p.historicalRootProof(ctx, currentSlot, targetSlot)
 	} else {
-		return receiptsRootProof, nil
+		beaconStateProof, err = p.historicalSummaryProof(ctx, currentSlot, targetSlot)
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	return append(receiptsRootProof, beaconStateProof...), nil
 }
 
-func (p *ReceiptRootProver) historicalRootProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) ([][]byte, error) {
+// VerifyReceiptsRootProof recomputes the beacon block root of the current slot from the receipts root
+// of the target slot and the proof returned by ReceiptsRootProof and compares it to the header root
+// of the current slot.
+func (p *ReceiptRootProver) VerifyReceiptsRootProof(
+	ctx context.Context,
+	currentSlot *big.Int,
+	targetSlot *big.Int,
+	receiptsRoot [32]byte,
+	proof [][]byte,
+) error {
 	beaconBlock, err := p.beaconClient.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
 		Block: currentSlot.String(),
 	})
+	if err != nil {
+		return err
+	}
+
+	gindex, err := p.receiptsRootGindex(ctx, currentSlot, targetSlot)
+	if err != nil {
+		return err
+	}
+	ok, err := ssz.VerifyProof(beaconBlock.Data.Root[:], &ssz.Proof{
+		Index:  int(gindex),
+		Leaf:   receiptsRoot[:],
+		Hashes: proof,
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("receipts root proof for slot %s does not match block root %s of slot %s", targetSlot, beaconBlock.Data.Root, currentSlot)
+	}
+
+	return nil
+}
+
+// receiptsRootGindex returns the generalized index of the target slot receipts root
+// in the beacon block of the current slot
+func (p *ReceiptRootProver) receiptsRootGindex(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) (int64, error) {
+	targetVersion, err := p.forkVersion(targetSlot)
+	if err != nil {
+		return 0, err
+	}
+	targetGindices, err := gindices(targetVersion)
+	if err != nil {
+		return 0, err
+	}
+	if currentSlot.Cmp(targetSlot) == 0 {
+		return targetGindices.receiptsRoot, nil
+	}
+
+	currentVersion, err := p.forkVersion(currentSlot)
+	if err != nil {
+		return 0, err
+	}
+	currentGindices, err := gindices(currentVersion)
+	if err != nil {
+		return 0, err
+	}
+	rootGindex := p.blockRootGindex(targetSlot)
+	if p.isInBlockRoots(currentSlot, targetSlot) {
+		return concatGindices([]int64{
+			BEACON_STATE_GINDEX,
+			concatGindices([]int64{currentGindices.blockRoots, rootGindex}),
+			targetGindices.receiptsRoot,
+		}), nil
+	}
+
+	state, err := p.beaconState(ctx, currentSlot)
+	if err != nil {
+		return 0, err
+	}
+	summaryIndex, err := p.historicalSummaryIndex(state.historicalSummaries, currentSlot, targetSlot)
+	if err != nil {
+		return 0, err
+	}
+	return concatGindices([]int64{
+		BEACON_STATE_GINDEX,
+		historicalSummaryGindex(currentGindices.historicalSummaries, summaryIndex),
+		rootGindex,
+		targetGindices.receiptsRoot,
+	}), nil
+}
+
+func (p *ReceiptRootProver) historicalRootProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) ([][]byte, error) {
+	stateProof, err := p.stateProof(ctx, currentSlot)
 	if err != nil {
 		return nil, err
 	}
-	headerTree, err := beaconBlock.Data.Header.Message.GetTree()
+
+	state, err := p.beaconState(ctx, currentSlot)
 	if err != nil {
 		return nil, err
 	}
-	stateProof, err := headerTree.Prove(BEACON_STATE_GINDEX)
+	stateGindices, err := gindices(state.version)
+	if err != nil {
+		return nil, err
+	}
+
+	stateTree, err := state.state.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	rootGindex := p.blockRootGindex(targetSlot)
+	historicalRootProof, err := stateTree.Prove(int(concatGindices([]int64{stateGindices.blockRoots, rootGindex})))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(historicalRootProof.Hashes, stateProof...), nil
+}
+
+// historicalSummaryProof proves the target slot block root through the block summary root of
+// the historical summary that contains it.
+// Block roots are taken from the state at the first slot after the summarized period as they
+// match the summarized block roots exactly.
+func (p *ReceiptRootProver) historicalSummaryProof(ctx context.Context, currentSlot *big.Int, targetSlot *big.Int) ([][]byte, error) {
+	stateProof, err := p.stateProof(ctx, currentSlot)
 	if err != nil {
 		return nil, err
 	}
@@ -109,33 +236,105 @@ func (p *ReceiptRootProver) historicalRootProof(ctx context.Context, currentSlot
 	if err != nil {
 		return nil, err
 	}
+	stateGindices, err := gindices(state.version)
+	if err != nil {
+		return nil, err
+	}
+	summaryIndex, err := p.historicalSummaryIndex(state.historicalSummaries, currentSlot, targetSlot)
+	if err != nil {
+		return nil, err
+	}
+	stateTree, err := state.state.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	summaryProof, err := stateTree.Prove(int(historicalSummaryGindex(stateGindices.historicalSummaries, summaryIndex)))
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerHistoricalRoot := new(big.Int).SetUint64(p.spec.SlotsPerHistoricalRoot)
+	summarySlot := new(big.Int).Mul(
+		new(big.Int).Add(new(big.Int).Div(targetSlot, slotsPerHistoricalRoot), big.NewInt(1)),
+		slotsPerHistoricalRoot)
+	summaryState, err := p.beaconState(ctx, summarySlot)
+	if err != nil {
+		return nil, err
+	}
+	summaryStateGindices, err := gindices(summaryState.version)
+	if err != nil {
+		return nil, err
+	}
+	summaryStateTree, err := summaryState.state.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	rootGindex := p.blockRootGindex(targetSlot)
+	blockRootsProof, err := summaryStateTree.Prove(int(concatGindices([]int64{summaryStateGindices.blockRoots, rootGindex})))
+	if err != nil {
+		return nil, err
+	}
 
-	stateTree, err := p.stateTree(state.Data.Deneb)
+	proof := append(blockRootsProof.Hashes[:bits.TrailingZeros64(p.spec.SlotsPerHistoricalRoot)], summaryProof.Hashes...)
+	return append(proof, stateProof...), nil
+}
+
+// stateProof returns the proof from the beacon block root to the beacon state root of the given slot
+func (p *ReceiptRootProver) stateProof(ctx context.Context, slot *big.Int) ([][]byte, error) {
+	beaconBlock, err := p.beaconClient.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+		Block: slot.String(),
+	})
 	if err != nil {
 		return nil, err
 	}
-	rootGindex := calculateArrayGindex(new(big.Int).Mod(targetSlot, big.NewInt(SLOTS_PER_HISTORICAL_LIMIT)))
-	historicalRootProof, err := stateTree.Prove(int(concatGindices([]int64{BLOCK_ROOTS_GINDEX, rootGindex})))
+	headerTree, err := beaconBlock.Data.Header.Message.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	stateProof, err := headerTree.Prove(BEACON_STATE_GINDEX)
 	if err != nil {
 		return nil, err
 	}
 
-	return append(historicalRootProof.Hashes, stateProof.Hashes...), nil
+	return stateProof.Hashes, nil
 }
 
-func (p *ReceiptRootProver) beaconState(ctx context.Context, slot *big.Int) (*api.Response[*spec.VersionedBeaconState], error) {
+func (p *ReceiptRootProver) beaconState(ctx context.Context, slot *big.Int) (*versionedState, error) {
 	cachedState, ok := p.stateCache.Get(slot.String())
 	if ok {
-		return cachedState.(*api.Response[*spec.VersionedBeaconState]), nil
+		return cachedState.(*versionedState), nil
 	}
 
-	state, err := p.beaconStateFetcher.BeaconState(ctx, &api.BeaconStateOpts{
-		State: strconv.FormatUint(slot.Uint64(), 10),
-	})
+	if p.stateStore != nil {
+		state, err := p.storedState(slot)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Discarding stored state of slot %s", slot)
+			err = p.stateStore.DeleteState(slot)
+			if err != nil {
+				log.Err(err).Msgf("Failed deleting state of slot %s from store", slot)
+			}
+		} else if state != nil {
+			p.stateCache.Set(slot.String(), state, cache.DefaultExpiration)
+			return state, nil
+		}
+	}
+
+	stateData, err := p.beaconStateFetcher.BeaconStateSSZ(ctx, slot.String())
+	if err != nil {
+		return nil, err
+	}
+	state, err := p.decodeState(stateData)
 	if err != nil {
 		return nil, err
 	}
 
+	if p.stateStore != nil {
+		err = p.stateStore.StoreState(slot, stateData)
+		if err != nil {
+			log.Err(err).Msgf("Failed saving state of slot %s to store", slot)
+		}
+	}
+
 	err = p.stateCache.Add(slot.String(), state, cache.DefaultExpiration)
 	if err != nil {
 		log.Err(err).Msgf("Failed saving state to cache")
@@ -144,66 +343,99 @@ func (p *ReceiptRootProver) beaconState(ctx context.Context, slot *big.Int) (*ap
 	return state, nil
 }
 
-func (p *ReceiptRootProver) stateTree(state *deneb.BeaconState) (*ssz.Node, error) {
-	if p.spec == config.GnosisSpec {
-		stateData, err := state.MarshalJSON()
-		if err != nil {
-			return nil, err
-		}
-		gnosisState := &gnosisDeneb.BeaconState{}
-		err = gnosisState.UnmarshalJSON(stateData)
-		if err != nil {
-			return nil, err
-		}
-
-		return gnosisState.GetTree()
+// storedState returns the decoded state of the given slot from the state store or nil
+// if the state is not stored
+func (p *ReceiptRootProver) storedState(slot *big.Int) (*versionedState, error) {
+	stateData, err := p.stateStore.State(slot)
+	if err != nil || stateData == nil {
+		return nil, err
+	}
+	state, err := p.decodeState(stateData)
+	if err != nil {
+		return nil, err
+	}
+	if state.slot != slot.Uint64() {
+		return nil, fmt.Errorf("stored state is of slot %d", state.slot)
 	}
 
-	return state.GetTree()
+	return state, nil
 }
 
-func (p *ReceiptRootProver) blockTree(block *deneb.BeaconBlock) (*ssz.Node, error) {
-	if p.spec == config.GnosisSpec {
-		blockData, err := block.MarshalJSON()
-		if err != nil {
-			return nil, err
-		}
-		gnosisBlock := &gnosisDeneb.BeaconBlock{}
-		err = gnosisBlock.UnmarshalJSON(blockData)
-		if err != nil {
-			return nil, err
-		}
-
-		return gnosisBlock.GetTree()
+func (p *ReceiptRootProver) signedBeaconBlock(ctx context.Context, slot *big.Int) (*versionedBlock, error) {
+	blockData, err := p.beaconClient.SignedBeaconBlockSSZ(ctx, slot.String())
+	if err != nil {
+		return nil, err
 	}
 
-	return block.GetTree()
+	return p.decodeBlock(blockData)
 }
 
 func (p *ReceiptRootProver) receiptsRootProof(ctx context.Context, slot *big.Int) ([][]byte, error) {
-	beaconBlock, err := p.beaconClient.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
-		Block: slot.String(),
-	})
+	beaconBlock, err := p.signedBeaconBlock(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	blockTree, err := p.blockTree(beaconBlock.Data.Deneb.Message)
+	blockGindices, err := gindices(beaconBlock.version)
 	if err != nil {
 		return nil, err
 	}
-	receiptsRootProof, err := blockTree.Prove(RECEIPTS_ROOT_GINDEX)
+	blockTree, err := beaconBlock.block.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	receiptsRootProof, err := blockTree.Prove(int(blockGindices.receiptsRoot))
 	if err != nil {
 		return nil, err
 	}
 	return receiptsRootProof.Hashes, nil
 }
 
-func calculateArrayGindex(elementIndex *big.Int) int64 {
+// isInBlockRoots checks if the target slot block root is still stored in the
+// block roots of the current slot state
+func (p *ReceiptRootProver) isInBlockRoots(currentSlot *big.Int, targetSlot *big.Int) bool {
+	return new(big.Int).Sub(currentSlot, targetSlot).Cmp(new(big.Int).SetUint64(p.spec.SlotsPerHistoricalRoot)) <= 0
+}
+
+// blockRootGindex returns the generalized index of the target slot root in block roots
+func (p *ReceiptRootProver) blockRootGindex(targetSlot *big.Int) int64 {
+	return calculateArrayGindex(
+		new(big.Int).Mod(targetSlot, new(big.Int).SetUint64(p.spec.SlotsPerHistoricalRoot)),
+		int64(p.spec.SlotsPerHistoricalRoot))
+}
+
+// historicalSummaryIndex calculates the index of the historical summary containing the target slot.
+// The last summary of the current state is the summary of the period before the current slot period.
+func (p *ReceiptRootProver) historicalSummaryIndex(summaryCount int, currentSlot *big.Int, targetSlot *big.Int) (int64, error) {
+	slotsPerHistoricalRoot := new(big.Int).SetUint64(p.spec.SlotsPerHistoricalRoot)
+	currentPeriod := new(big.Int).Div(currentSlot, slotsPerHistoricalRoot)
+	targetPeriod := new(big.Int).Div(targetSlot, slotsPerHistoricalRoot)
+	index := int64(summaryCount) - new(big.Int).Sub(currentPeriod, targetPeriod).Int64()
+	if index < 0 || index >= int64(summaryCount) {
+		return 0, fmt.Errorf(
+			"slot %s is neither in block roots nor in %d historical summaries of slot %s",
+			targetSlot, summaryCount, currentSlot)
+	}
+
+	return index, nil
+}
+
+// historicalSummaryGindex returns the beacon state generalized index of the block summary root
+// of the historical summary with the given index
+func historicalSummaryGindex(historicalSummariesGindex int64, summaryIndex int64) int64 {
+	return concatGindices([]int64{
+		historicalSummariesGindex,
+		LIST_DATA_GINDEX,
+		calculateArrayGindex(big.NewInt(summaryIndex), HISTORICAL_SUMMARIES_LIMIT),
+		BLOCK_SUMMARY_ROOT_GINDEX,
+	})
+}
+
+func calculateArrayGindex(elementIndex *big.Int, length int64) int64 {
 	gindex := int64(1)
 	index := elementIndex.Int64()
 
 	depth := 0
-	for (1 << depth) < SLOTS_PER_HISTORICAL_LIMIT {
+	for (1 << depth) < length {
 		depth++
 	}
 	for d := 0; d < depth; d++ {
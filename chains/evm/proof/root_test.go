@@ -8,19 +8,32 @@ import (
 	"encoding/hex"
 	"math/big"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	gnosisDeneb "github.com/mpetrun5/go-eth2-client/spec/deneb"
 	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof/gnosis"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
 	"go.uber.org/mock/gomock"
 )
 
+const slotsPerHistoricalRoot = 8192
+
 type ReceiptRootProofTestSuite struct {
 	suite.Suite
 
@@ -38,29 +51,23 @@ func (s *ReceiptRootProofTestSuite) SetupTest() {
 	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
 	s.mockArchiveBeaconClient = mock.NewMockBeaconStateFetcher(ctrl)
 
-	beaconState := &spec.VersionedBeaconState{
-		Deneb: &deneb.BeaconState{},
-	}
+	beaconState := &deneb.BeaconState{}
 	beaconBytes, err := os.ReadFile("./stubs/state.json")
 	if err != nil {
 		panic(err)
 	}
-	_ = beaconState.Deneb.UnmarshalJSON(beaconBytes)
-	s.mockArchiveBeaconClient.EXPECT().BeaconState(gomock.Any(), gomock.Any()).Return(&api.Response[*spec.VersionedBeaconState]{
-		Data: beaconState,
-	}, nil).AnyTimes()
+	_ = beaconState.UnmarshalJSON(beaconBytes)
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionDeneb, beaconState), nil).AnyTimes()
 
-	block := &spec.VersionedSignedBeaconBlock{
-		Deneb: &deneb.SignedBeaconBlock{},
-	}
+	block := &deneb.SignedBeaconBlock{}
 	blockBytes, err := os.ReadFile("./stubs/block.json")
 	if err != nil {
 		panic(err)
 	}
-	_ = block.Deneb.UnmarshalJSON(blockBytes)
-	s.mockBeaconClient.EXPECT().SignedBeaconBlock(gomock.Any(), gomock.Any()).Return(&api.Response[*spec.VersionedSignedBeaconBlock]{
-		Data: block,
-	}, nil).AnyTimes()
+	_ = block.UnmarshalJSON(blockBytes)
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionDeneb, block), nil).AnyTimes()
 
 	beaconBlockHeader := &apiv1.BeaconBlockHeader{}
 	headerBytes, err := os.ReadFile("./stubs/header.json")
@@ -72,7 +79,7 @@ func (s *ReceiptRootProofTestSuite) SetupTest() {
 		Data: beaconBlockHeader,
 	}, nil).AnyTimes()
 
-	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, config.MainnetSpec)
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), nil)
 }
 
 func (s *ReceiptRootProofTestSuite) Test_ReceiptRootProof_SlotDifferent() {
@@ -144,3 +151,703 @@ func (s *ReceiptRootProofTestSuite) Test_ReceiptRootProof_SameSlot() {
 	}
 
 }
+
+type ReceiptRootVerifyTestSuite struct {
+	suite.Suite
+
+	prover           *proof.ReceiptRootProver
+	mockBeaconClient *mock.MockBeaconClient
+	receiptsRoot     [32]byte
+	proof            [][]byte
+}
+
+func TestRunReceiptRootVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRootVerifyTestSuite))
+}
+
+func (s *ReceiptRootVerifyTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
+
+	beaconBlockHeader := &apiv1.BeaconBlockHeader{}
+	headerBytes, err := os.ReadFile("./stubs/header.json")
+	if err != nil {
+		panic(err)
+	}
+	_ = beaconBlockHeader.UnmarshalJSON(headerBytes)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: beaconBlockHeader,
+	}, nil).AnyTimes()
+
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(&beacon.SSZResponse{
+		Version: spec.DataVersionDeneb,
+	}, nil).AnyTimes()
+
+	receiptsRoot, _ := hex.DecodeString("7b2954b1bbe6308967f1dad775f0507bee594d3ce44a7eda2ed1b6bcdb7c7b8d")
+	copy(s.receiptsRoot[:], receiptsRoot)
+	proofHashes := []string{
+		"f84e9fc70392b8a60d7655b1924c9aa98fb8ad0a6cbb8a3f945a1e5ddf83b9b3",
+		"97d7ab2b13aca2be860cd70d88b718f3ebe2ed251a4a032368812364c8298c9c",
+		"8b0ec84446342d9bc11852eb2bd7f2fa64d29389e3a6e28bffd2d2edc4e037a5",
+		"043cfab8f5f81f18af8f16eb656ccfbefebf8665aa9afd3a8639dd47168b7727",
+		"91b2a710625c221d8c5b33bdfaadd77ec67c07a6950ef1e24f9d5e7822ecde33",
+		"d5f4a630033673a515b904c5a74a439a9eaba6f18f207795a98974abb1335fbb",
+		"b46f0c01805fe212e15907981b757e6c496b0cb06664224655613dcec82505bb",
+		"db56114e00fdd4c1f85c892bf35ac9a89289aaecb1ebd0a96cde606a748b5d71",
+		"44f37a37ebc7bf7ddcfb20d3b23e76e558e4f54ce6d902fa0e5de50f9ac36296",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b",
+		"7dbd839dc4fcf9a1b024067aab7d6e3fe2a30e18ae08a19db4c9243f49a28c67",
+		"324de181c3f04cb1a16f1bb16da93281c6d0201f220d28a849ce8ab2472f5baf",
+		"154c88980564c7b6112ffc6c5f231f64755cc61d8dd3630ea62449605d4ee1ab",
+		"4ee7055436bf367dc95da1447e06bcb3a01f666b6b0a3849e41531f9fe1a027b",
+		"0f7f0a89fec31344d9951eef426f6e66c2b3046110dc89dd3cf8bdd67a1629ae",
+		"c55a2b1579d3b1070ebd7c632dc015c2534f7d571dfefb51bf6fe84b61d08a44",
+		"08ff7acf2cafee5dc6830950f71a11cfbce05fa3b75df7b99f5525df86f63792",
+		"1ef8cdf86082b48a9f28e6a9db31cee9b6808cd5b3ed8c8ab9eac64c01cdfba6",
+		"a12c7734c8170b73272ec633ac1b3c5441ca3f1f0508d024c43c1a3162cf5af4",
+		"c6671a889699ae057f4a9b4f836d1913c2059a2c78f1dfeed8891f4ce27ec00d",
+		"f40656ff428eed796debe9a19f1caa71c64750f70641ad325298cb9c22f5f7bc",
+		"955510b9f25c5799bdb2641599dbe5acc2c30527db35b0f29750fc556aea565d",
+		"5dbab0cfd3d8cb1ecf549645837c9e13f40e224147462d8d602f6604aa91effe",
+		"df45c7d3b2b5bf7827f2fb9d827c87076a05a8b314d200b8d4832e1c3413eef2",
+		"521b6c5edd664ac6e622e668bfb2fb1c13590d31318b24179106c04a35c9f5bb",
+		"c54ea91aff432927f329abac170cf288c086159e640b6c24fcc03a416b5fc0b1",
+		"549bdebf58b3048217f3853462337b18fb410d36768f35fa227b6e8bbedd5e82",
+		"eab572026cded9cddada269b7054f84d839d2d424ee93065f9d07c026c24b7eb",
+		"51df3ff10a34eafbecc355d793e4a12d43bc0df8787d2ec345e5b685e7f71da1",
+		"7f81497ad30b4d3215e6c18222b277bf0dbc6ba315b1df3dd49a45c09f8aa569",
+		"59f0fb8994c3f33d8aefaf635e57bd4732cc6f718e09ed4e5d78ca1e4876a802",
+		"01a16c157ec2dca5bd7eef2436d104d5c6fd970b476bcec30f41baabbeddc815",
+	}
+	s.proof = make([][]byte, len(proofHashes))
+	for i, hash := range proofHashes {
+		s.proof[i], _ = hex.DecodeString(hash)
+	}
+
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, mock.NewMockBeaconStateFetcher(ctrl), testChainSpec("deneb"), nil)
+}
+
+func (s *ReceiptRootVerifyTestSuite) Test_VerifyReceiptsRootProof_ValidProof() {
+	err := s.prover.VerifyReceiptsRootProof(context.Background(), big.NewInt(5544654), big.NewInt(5544653), s.receiptsRoot, s.proof)
+
+	s.Nil(err)
+}
+
+func (s *ReceiptRootVerifyTestSuite) Test_VerifyReceiptsRootProof_InvalidSlot() {
+	err := s.prover.VerifyReceiptsRootProof(context.Background(), big.NewInt(5544654), big.NewInt(5544652), s.receiptsRoot, s.proof)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptRootVerifyTestSuite) Test_VerifyReceiptsRootProof_InvalidReceiptsRoot() {
+	err := s.prover.VerifyReceiptsRootProof(context.Background(), big.NewInt(5544654), big.NewInt(5544653), [32]byte{1}, s.proof)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptRootVerifyTestSuite) Test_VerifyReceiptsRootProof_InvalidProofLength() {
+	err := s.prover.VerifyReceiptsRootProof(context.Background(), big.NewInt(5544654), big.NewInt(5544653), s.receiptsRoot, s.proof[:12])
+
+	s.NotNil(err)
+}
+
+type ReceiptRootHistoricalSummaryTestSuite struct {
+	suite.Suite
+
+	prover                  *proof.ReceiptRootProver
+	mockBeaconClient        *mock.MockBeaconClient
+	mockArchiveBeaconClient *mock.MockBeaconStateFetcher
+	block                   *deneb.SignedBeaconBlock
+	targetSlot              uint64
+	currentSlot             uint64
+}
+
+func TestRunReceiptRootHistoricalSummaryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRootHistoricalSummaryTestSuite))
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
+	s.mockArchiveBeaconClient = mock.NewMockBeaconStateFetcher(ctrl)
+
+	s.block = &deneb.SignedBeaconBlock{}
+	blockBytes, err := os.ReadFile("./stubs/block.json")
+	if err != nil {
+		panic(err)
+	}
+	_ = s.block.UnmarshalJSON(blockBytes)
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionDeneb, s.block), nil).AnyTimes()
+
+	s.targetSlot = uint64(s.block.Message.Slot)
+	s.currentSlot = s.targetSlot + 3*slotsPerHistoricalRoot + 5
+
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), nil)
+}
+
+// mockStates mocks the state at the first slot after the target slot period with the target block root and
+// the current slot state with the given number of historical summaries
+func (s *ReceiptRootHistoricalSummaryTestSuite) mockStates(summaryCount int) {
+	blockRoot, _ := s.block.Message.HashTreeRoot()
+	summarySlot := (s.targetSlot/slotsPerHistoricalRoot + 1) * slotsPerHistoricalRoot
+	summaryState := testBeaconState(summarySlot)
+	summaryState.BlockRoots[s.targetSlot%slotsPerHistoricalRoot] = blockRoot
+	summaryStateTree, _ := summaryState.GetTree()
+	blockRootsNode, _ := summaryStateTree.Get(int(proof.BLOCK_ROOTS_GINDEX))
+
+	currentState := testBeaconState(s.currentSlot)
+	currentState.HistoricalSummaries = make([]*capella.HistoricalSummary, summaryCount)
+	for i := range currentState.HistoricalSummaries {
+		currentState.HistoricalSummaries[i] = &capella.HistoricalSummary{}
+	}
+	if summaryCount >= 3 {
+		copy(currentState.HistoricalSummaries[summaryCount-3].BlockSummaryRoot[:], blockRootsNode.Hash())
+	}
+	stateRoot, _ := currentState.HashTreeRoot()
+
+	header := &phase0.BeaconBlockHeader{
+		Slot:      phase0.Slot(s.currentSlot),
+		StateRoot: stateRoot,
+	}
+	headerRoot, _ := header.HashTreeRoot()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: headerRoot,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: header,
+			},
+		},
+	}, nil).AnyTimes()
+
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(s.currentSlot, 10)).Return(
+		sszResponse(spec.DataVersionDeneb, currentState), nil).AnyTimes()
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(summarySlot, 10)).Return(
+		sszResponse(spec.DataVersionDeneb, summaryState), nil).AnyTimes()
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_HistoricalSummary() {
+	s.mockStates(10)
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+
+	s.Nil(err)
+	s.Equal(len(p), 59)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot),
+		s.block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_StoredStates() {
+	s.mockStates(10)
+	stateStore, err := store.NewBeaconStateStore(s.T().TempDir(), "mainnet", 2)
+	s.Nil(err)
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), stateStore)
+	expectedProof, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+	s.Nil(err)
+	s.prover = proof.NewReceiptRootProver(
+		s.mockBeaconClient, mock.NewMockBeaconStateFetcher(gomock.NewController(s.T())), testChainSpec("deneb"), stateStore)
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+
+	s.Nil(err)
+	s.Equal(p, expectedProof)
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_CorruptedStoredState() {
+	s.mockStates(10)
+	stateStore, err := store.NewBeaconStateStore(s.T().TempDir(), "mainnet", 2)
+	s.Nil(err)
+	err = stateStore.StoreState(new(big.Int).SetUint64(s.currentSlot), &beacon.SSZResponse{
+		Version: spec.DataVersionDeneb,
+		Data:    []byte{1, 2, 3},
+	})
+	s.Nil(err)
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), stateStore)
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+
+	s.Nil(err)
+	s.Equal(len(p), 59)
+	storedState, err := stateStore.State(new(big.Int).SetUint64(s.currentSlot))
+	s.Nil(err)
+	s.NotEqual(storedState.Data, []byte{1, 2, 3})
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_StoredStateOfDifferentSlot() {
+	s.mockStates(10)
+	stateStore, err := store.NewBeaconStateStore(s.T().TempDir(), "mainnet", 2)
+	s.Nil(err)
+	err = stateStore.StoreState(
+		new(big.Int).SetUint64(s.currentSlot), sszResponse(spec.DataVersionDeneb, testBeaconState(s.currentSlot+1)))
+	s.Nil(err)
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), stateStore)
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+
+	s.Nil(err)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot),
+		s.block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_MissingHistoricalSummary() {
+	s.mockStates(2)
+
+	_, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.currentSlot),
+		new(big.Int).SetUint64(s.targetSlot))
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptRootHistoricalSummaryTestSuite) Test_ReceiptRootProof_TargetSlotAfterCurrentSlot() {
+	_, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(s.targetSlot),
+		new(big.Int).SetUint64(s.currentSlot))
+
+	s.NotNil(err)
+}
+
+func testChainSpec(fork string) *config.ChainSpec {
+	return &config.ChainSpec{
+		Preset:                 config.MainnetPreset,
+		SlotsPerEpoch:          32,
+		SlotsPerHistoricalRoot: slotsPerHistoricalRoot,
+		SecondsPerSlot:         12,
+		ForkEpochs:             map[string]uint64{fork: 0},
+	}
+}
+
+// sszResponse returns the SSZ encoded container as returned by the beacon node
+func sszResponse(version spec.DataVersion, container ssz.Marshaler) *beacon.SSZResponse {
+	data, _ := container.MarshalSSZ()
+	return &beacon.SSZResponse{
+		Version: version,
+		Data:    data,
+	}
+}
+
+func testBeaconState(slot uint64) *deneb.BeaconState {
+	return &deneb.BeaconState{
+		Slot:                         phase0.Slot(slot),
+		Fork:                         &phase0.Fork{},
+		LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+		BlockRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:                  make([]phase0.Root, 65536),
+		Slashings:                    make([]phase0.Gwei, 8192),
+		JustificationBits:            []byte{0},
+		PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+		FinalizedCheckpoint:          &phase0.Checkpoint{},
+		CurrentSyncCommittee:         &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		NextSyncCommittee:            &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{BaseFeePerGas: uint256.NewInt(0)},
+	}
+}
+
+type ReceiptRootForkTestSuite struct {
+	suite.Suite
+
+	prover                  *proof.ReceiptRootProver
+	mockBeaconClient        *mock.MockBeaconClient
+	mockArchiveBeaconClient *mock.MockBeaconStateFetcher
+	blockBytes              []byte
+}
+
+func TestRunReceiptRootForkTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRootForkTestSuite))
+}
+
+func (s *ReceiptRootForkTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
+	s.mockArchiveBeaconClient = mock.NewMockBeaconStateFetcher(ctrl)
+
+	blockBytes, err := os.ReadFile("./stubs/block.json")
+	if err != nil {
+		panic(err)
+	}
+	s.blockBytes = blockBytes
+
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("deneb"), nil)
+}
+
+func (s *ReceiptRootForkTestSuite) Test_ReceiptRootProof_Capella() {
+	block := &capella.SignedBeaconBlock{}
+	err := block.UnmarshalJSON(s.blockBytes)
+	s.Nil(err)
+	blockRoot, _ := block.Message.HashTreeRoot()
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionCapella, block), nil).AnyTimes()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: blockRoot,
+		},
+	}, nil)
+
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("capella"), nil)
+
+	p, err := s.prover.ReceiptsRootProof(context.Background(), big.NewInt(5544653), big.NewInt(5544653))
+
+	s.Nil(err)
+	s.Equal(len(p), 11)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		big.NewInt(5544653),
+		big.NewInt(5544653),
+		block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootForkTestSuite) Test_ReceiptRootProof_UnsupportedFork() {
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(&beacon.SSZResponse{
+		Version: spec.DataVersionBellatrix,
+	}, nil)
+
+	_, err := s.prover.ReceiptsRootProof(context.Background(), big.NewInt(5544653), big.NewInt(5544653))
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptRootForkTestSuite) Test_ReceiptRootProof_GnosisPreset() {
+	blockBytes, err := os.ReadFile("./stubs/gnosis_block.json")
+	s.Nil(err)
+	block := &gnosisDeneb.SignedBeaconBlock{}
+	err = block.UnmarshalJSON(blockBytes)
+	s.Nil(err)
+	blockRoot, _ := block.Message.HashTreeRoot()
+	blockData, _ := block.MarshalSSZ()
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(&beacon.SSZResponse{
+		Version: spec.DataVersionDeneb,
+		Data:    blockData,
+	}, nil).AnyTimes()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: blockRoot,
+		},
+	}, nil)
+	chainSpec := testChainSpec("deneb")
+	chainSpec.Preset = config.GnosisPreset
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, chainSpec, nil)
+
+	p, err := s.prover.ReceiptsRootProof(context.Background(), big.NewInt(5544653), big.NewInt(5544653))
+
+	s.Nil(err)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		big.NewInt(5544653),
+		big.NewInt(5544653),
+		block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootForkTestSuite) Test_ReceiptRootProof_GnosisCapellaHistoricalSummary() {
+	blockBytes, err := os.ReadFile("./stubs/gnosis_block.json")
+	s.Nil(err)
+	block := &capella.SignedBeaconBlock{}
+	err = block.UnmarshalJSON(blockBytes)
+	s.Nil(err)
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionCapella, block), nil).AnyTimes()
+	blockData, _ := block.MarshalSSZ()
+	gnosisBlock := &gnosis.CapellaSignedBeaconBlock{}
+	err = gnosisBlock.UnmarshalSSZ(blockData)
+	s.Nil(err)
+	blockRoot, _ := gnosisBlock.Message.HashTreeRoot()
+	mainnetRoot, _ := block.Message.HashTreeRoot()
+	s.NotEqual(blockRoot, mainnetRoot)
+
+	targetSlot := uint64(block.Message.Slot)
+	currentSlot := targetSlot + 3*slotsPerHistoricalRoot + 5
+	summarySlot := (targetSlot/slotsPerHistoricalRoot + 1) * slotsPerHistoricalRoot
+	summaryState := testGnosisCapellaBeaconState(summarySlot)
+	summaryState.BlockRoots[targetSlot%slotsPerHistoricalRoot] = blockRoot
+	summaryStateTree, _ := summaryState.GetTree()
+	blockRootsNode, _ := summaryStateTree.Get(int(proof.BLOCK_ROOTS_GINDEX))
+
+	currentState := testGnosisCapellaBeaconState(currentSlot)
+	currentState.HistoricalSummaries = make([]*capella.HistoricalSummary, 10)
+	for i := range currentState.HistoricalSummaries {
+		currentState.HistoricalSummaries[i] = &capella.HistoricalSummary{}
+	}
+	copy(currentState.HistoricalSummaries[7].BlockSummaryRoot[:], blockRootsNode.Hash())
+	stateRoot, _ := currentState.HashTreeRoot()
+	header := &phase0.BeaconBlockHeader{
+		Slot:      phase0.Slot(currentSlot),
+		StateRoot: stateRoot,
+	}
+	headerRoot, _ := header.HashTreeRoot()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: headerRoot,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: header,
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(currentSlot, 10)).Return(
+		sszResponse(spec.DataVersionCapella, currentState), nil).AnyTimes()
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(summarySlot, 10)).Return(
+		sszResponse(spec.DataVersionCapella, summaryState), nil).AnyTimes()
+	chainSpec := testChainSpec("capella")
+	chainSpec.Preset = config.GnosisPreset
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, chainSpec, nil)
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(currentSlot),
+		new(big.Int).SetUint64(targetSlot))
+
+	s.Nil(err)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(currentSlot),
+		new(big.Int).SetUint64(targetSlot),
+		block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func testGnosisCapellaBeaconState(slot uint64) *gnosis.CapellaBeaconState {
+	return &gnosis.CapellaBeaconState{
+		Slot:                         phase0.Slot(slot),
+		Fork:                         &phase0.Fork{},
+		LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+		BlockRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:                  make([]phase0.Root, 65536),
+		Slashings:                    make([]phase0.Gwei, 8192),
+		JustificationBits:            []byte{0},
+		PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+		FinalizedCheckpoint:          &phase0.Checkpoint{},
+		CurrentSyncCommittee:         &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		NextSyncCommittee:            &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+	}
+}
+
+type ReceiptRootElectraTestSuite struct {
+	suite.Suite
+
+	prover                  *proof.ReceiptRootProver
+	mockBeaconClient        *mock.MockBeaconClient
+	mockArchiveBeaconClient *mock.MockBeaconStateFetcher
+	block                   *electra.SignedBeaconBlock
+}
+
+func TestRunReceiptRootElectraTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRootElectraTestSuite))
+}
+
+func (s *ReceiptRootElectraTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBeaconClient = mock.NewMockBeaconClient(ctrl)
+	s.mockArchiveBeaconClient = mock.NewMockBeaconStateFetcher(ctrl)
+
+	blockBytes, err := os.ReadFile("./stubs/block.json")
+	if err != nil {
+		panic(err)
+	}
+	s.block = testElectraBlock(blockBytes)
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, testChainSpec("electra"), nil)
+}
+
+func (s *ReceiptRootElectraTestSuite) Test_ReceiptRootProof_SameSlot() {
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionElectra, s.block), nil).AnyTimes()
+	blockRoot, _ := s.block.Message.HashTreeRoot()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: blockRoot,
+		},
+	}, nil)
+
+	p, err := s.prover.ReceiptsRootProof(context.Background(), big.NewInt(5544653), big.NewInt(5544653))
+
+	s.Nil(err)
+	s.Equal(len(p), 12)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		big.NewInt(5544653),
+		big.NewInt(5544653),
+		s.block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootElectraTestSuite) Test_ReceiptRootProof_GnosisPreset() {
+	blockBytes, err := os.ReadFile("./stubs/gnosis_block.json")
+	s.Nil(err)
+	block := testElectraBlock(blockBytes)
+	blockData, _ := block.MarshalSSZ()
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(&beacon.SSZResponse{
+		Version: spec.DataVersionElectra,
+		Data:    blockData,
+	}, nil).AnyTimes()
+	gnosisBlock := &gnosis.ElectraSignedBeaconBlock{}
+	err = gnosisBlock.UnmarshalSSZ(blockData)
+	s.Nil(err)
+	blockRoot, _ := gnosisBlock.Message.HashTreeRoot()
+	mainnetRoot, _ := block.Message.HashTreeRoot()
+	s.NotEqual(blockRoot, mainnetRoot)
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: blockRoot,
+		},
+	}, nil)
+	chainSpec := testChainSpec("electra")
+	chainSpec.Preset = config.GnosisPreset
+	s.prover = proof.NewReceiptRootProver(s.mockBeaconClient, s.mockArchiveBeaconClient, chainSpec, nil)
+
+	p, err := s.prover.ReceiptsRootProof(context.Background(), big.NewInt(5544653), big.NewInt(5544653))
+
+	s.Nil(err)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		big.NewInt(5544653),
+		big.NewInt(5544653),
+		block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+func (s *ReceiptRootElectraTestSuite) Test_ReceiptRootProof_HistoricalSummary() {
+	s.mockBeaconClient.EXPECT().SignedBeaconBlockSSZ(gomock.Any(), gomock.Any()).Return(
+		sszResponse(spec.DataVersionElectra, s.block), nil).AnyTimes()
+	targetSlot := uint64(s.block.Message.Slot)
+	currentSlot := targetSlot + 3*slotsPerHistoricalRoot + 5
+	blockRoot, _ := s.block.Message.HashTreeRoot()
+	summarySlot := (targetSlot/slotsPerHistoricalRoot + 1) * slotsPerHistoricalRoot
+	summaryState := testElectraBeaconState(summarySlot)
+	summaryState.BlockRoots[targetSlot%slotsPerHistoricalRoot] = blockRoot
+	summaryStateTree, _ := summaryState.GetTree()
+	blockRootsNode, _ := summaryStateTree.Get(int(proof.ELECTRA_BLOCK_ROOTS_GINDEX))
+
+	currentState := testElectraBeaconState(currentSlot)
+	currentState.HistoricalSummaries = make([]*capella.HistoricalSummary, 10)
+	for i := range currentState.HistoricalSummaries {
+		currentState.HistoricalSummaries[i] = &capella.HistoricalSummary{}
+	}
+	copy(currentState.HistoricalSummaries[7].BlockSummaryRoot[:], blockRootsNode.Hash())
+	stateRoot, _ := currentState.HashTreeRoot()
+	header := &phase0.BeaconBlockHeader{
+		Slot:      phase0.Slot(currentSlot),
+		StateRoot: stateRoot,
+	}
+	headerRoot, _ := header.HashTreeRoot()
+	s.mockBeaconClient.EXPECT().BeaconBlockHeader(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root: headerRoot,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: header,
+			},
+		},
+	}, nil).AnyTimes()
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(currentSlot, 10)).Return(
+		sszResponse(spec.DataVersionElectra, currentState), nil).AnyTimes()
+	s.mockArchiveBeaconClient.EXPECT().BeaconStateSSZ(gomock.Any(), strconv.FormatUint(summarySlot, 10)).Return(
+		sszResponse(spec.DataVersionElectra, summaryState), nil).AnyTimes()
+
+	p, err := s.prover.ReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(currentSlot),
+		new(big.Int).SetUint64(targetSlot))
+
+	s.Nil(err)
+	err = s.prover.VerifyReceiptsRootProof(
+		context.Background(),
+		new(big.Int).SetUint64(currentSlot),
+		new(big.Int).SetUint64(targetSlot),
+		s.block.Message.Body.ExecutionPayload.ReceiptsRoot,
+		p)
+	s.Nil(err)
+}
+
+// testElectraBlock converts the Deneb block stub into an Electra block without attestations
+func testElectraBlock(blockBytes []byte) *electra.SignedBeaconBlock {
+	block := &deneb.SignedBeaconBlock{}
+	_ = block.UnmarshalJSON(blockBytes)
+	body := block.Message.Body
+	return &electra.SignedBeaconBlock{
+		Message: &electra.BeaconBlock{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &electra.BeaconBlockBody{
+				RANDAOReveal:          body.RANDAOReveal,
+				ETH1Data:              body.ETH1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     []*electra.AttesterSlashing{},
+				Attestations:          []*electra.Attestation{},
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      body.ExecutionPayload,
+				BLSToExecutionChanges: body.BLSToExecutionChanges,
+				BlobKZGCommitments:    body.BlobKZGCommitments,
+				ExecutionRequests: &electra.ExecutionRequests{
+					Deposits:       []*electra.DepositRequest{},
+					Withdrawals:    []*electra.WithdrawalRequest{},
+					Consolidations: []*electra.ConsolidationRequest{},
+				},
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func testElectraBeaconState(slot uint64) *electra.BeaconState {
+	state := testBeaconState(slot)
+	return &electra.BeaconState{
+		Slot:                         state.Slot,
+		Fork:                         state.Fork,
+		LatestBlockHeader:            state.LatestBlockHeader,
+		BlockRoots:                   state.BlockRoots,
+		StateRoots:                   state.StateRoots,
+		ETH1Data:                     state.ETH1Data,
+		RANDAOMixes:                  state.RANDAOMixes,
+		Slashings:                    state.Slashings,
+		JustificationBits:            state.JustificationBits,
+		PreviousJustifiedCheckpoint:  state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:          state.FinalizedCheckpoint,
+		CurrentSyncCommittee:         state.CurrentSyncCommittee,
+		NextSyncCommittee:            state.NextSyncCommittee,
+		LatestExecutionPayloadHeader: state.LatestExecutionPayloadHeader,
+	}
+}
@@ -0,0 +1,134 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/client"
+	"github.com/sygmaprotocol/sygma-core/crypto/secp256k1"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+	evmConfig "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+)
+
+type command func(ctx context.Context, args []string, out io.Writer) error
+
+var commands = map[string]map[string]command{
+	"prove": {
+		"receipt":       proveReceipt,
+		"receipts-root": proveReceiptsRoot,
+		"storage":       proveStorage,
+	},
+	"relay": {
+		"hashi": relayHashi,
+	},
+	"cursors": {
+		"list":   listCursors,
+		"set":    setCursor,
+		"rewind": rewindCursor,
+		"export": exportCursors,
+		"import": importCursors,
+	},
+}
+
+// Run executes the command given by the command line arguments and writes its output to out.
+// Commands use the domain configuration loaded from the environment in the same way the relayer does.
+func Run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s", usage())
+	}
+	subcommands, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %s, usage: %s", args[0], usage())
+	}
+	cmd, ok := subcommands[args[1]]
+	if !ok {
+		return fmt.Errorf("unknown command %s %s, usage: %s", args[0], args[1], usage())
+	}
+
+	return cmd(ctx, args[2:], out)
+}
+
+func usage() string {
+	usages := make([]string, 0)
+	for name, subcommands := range commands {
+		for subname := range subcommands {
+			usages = append(usages, fmt.Sprintf("%s %s [flags]", name, subname))
+		}
+	}
+	sort.Strings(usages)
+
+	return strings.Join(usages, " | ")
+}
+
+func printJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func evmClient(config *evmConfig.EVMConfig) (*client.EVMClient, error) {
+	kp, err := secp256k1.NewKeypairFromString(config.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewEVMClient(config.Endpoint, kp)
+}
+
+func beaconClients(ctx context.Context, config *evmConfig.EVMConfig) (*beacon.Client, *beacon.Client, error) {
+	beaconClient, err := http.New(ctx,
+		http.WithAddress(config.BeaconEndpoint),
+		http.WithLogLevel(zerolog.WarnLevel),
+		http.WithTimeout(time.Minute*15),
+		http.WithEnforceJSON(false),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	archiveBeaconClient, err := http.New(ctx,
+		http.WithAddress(config.ArchiveBeaconEndpoint),
+		http.WithLogLevel(zerolog.WarnLevel),
+		http.WithTimeout(time.Minute*30),
+		http.WithEnforceJSON(false),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beacon.NewClient(beaconClient.(*http.Service), time.Minute*15),
+		beacon.NewClient(archiveBeaconClient.(*http.Service), time.Minute*30),
+		nil
+}
+
+func receiptRootProver(
+	config *evmConfig.EVMConfig,
+	beaconClient *beacon.Client,
+	archiveBeaconClient *beacon.Client,
+) (*proof.ReceiptRootProver, error) {
+	chainSpec, err := evmConfig.LoadChainSpec(config.Spec, config.SpecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var stateStore proof.BeaconStateStore
+	if config.StateStorePath != "" {
+		stateStore, err = store.NewBeaconStateStore(config.StateStorePath, string(config.Spec), config.StateStoreSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proof.NewReceiptRootProver(beaconClient, archiveBeaconClient, chainSpec, stateStore), nil
+}
@@ -0,0 +1,69 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/cli"
+)
+
+type CLITestSuite struct {
+	suite.Suite
+}
+
+func TestRunCLITestSuite(t *testing.T) {
+	suite.Run(t, new(CLITestSuite))
+}
+
+func (s *CLITestSuite) Test_Run_MissingSubcommand() {
+	err := cli.Run(context.Background(), []string{"prove"}, &bytes.Buffer{})
+
+	s.NotNil(err)
+}
+
+func (s *CLITestSuite) Test_Run_UnknownCommand() {
+	err := cli.Run(context.Background(), []string{"prove", "invalid"}, &bytes.Buffer{})
+
+	s.NotNil(err)
+}
+
+func (s *CLITestSuite) Test_Run_InvalidFlag() {
+	err := cli.Run(context.Background(), []string{"prove", "receipt", "--invalid"}, &bytes.Buffer{})
+
+	s.NotNil(err)
+}
+
+func (s *CLITestSuite) Test_Run_MissingTransactionHash() {
+	err := cli.Run(context.Background(), []string{"prove", "receipt", "--domain", "1"}, &bytes.Buffer{})
+
+	s.ErrorContains(err, "transaction hash not set")
+}
+
+func (s *CLITestSuite) Test_Run_RelayHashi_MissingSlot() {
+	err := cli.Run(context.Background(), []string{"relay", "hashi", "--tx", "0x1"}, &bytes.Buffer{})
+
+	s.ErrorContains(err, "state root slot not set")
+}
+
+func (s *CLITestSuite) Test_Run_SetCursor_InvalidBlock() {
+	err := cli.Run(context.Background(), []string{"cursors", "set", "--block", "invalid"}, &bytes.Buffer{})
+
+	s.ErrorContains(err, "invalid block")
+}
+
+func (s *CLITestSuite) Test_Run_RewindCursor_MissingBlocks() {
+	err := cli.Run(context.Background(), []string{"cursors", "rewind", "--source", "1"}, &bytes.Buffer{})
+
+	s.ErrorContains(err, "number of blocks not set")
+}
+
+func (s *CLITestSuite) Test_Run_ImportCursors_MissingFile() {
+	err := cli.Run(context.Background(), []string{"cursors", "import"}, &bytes.Buffer{})
+
+	s.ErrorContains(err, "import file not set")
+}
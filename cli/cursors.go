@@ -0,0 +1,190 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/sygmaprotocol/sygma-inclusion-prover/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// listCursors prints all route and listener cursors
+func listCursors(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("cursors list", flag.ContinueOnError)
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	return withCursorStore(func(cursorStore *store.CursorStore) error {
+		cursors, err := cursorStore.Cursors()
+		if err != nil {
+			return err
+		}
+
+		for _, cursor := range cursors {
+			switch cursor.Type {
+			case store.RouteCursor:
+				fmt.Fprintf(out, "%s %d -> %d: %s\n", cursor.Type, cursor.Source, cursor.Destination, cursor.Block)
+			default:
+				fmt.Fprintf(out, "%s %d: %s\n", cursor.Type, cursor.Source, cursor.Block)
+			}
+		}
+		return nil
+	})
+}
+
+// setCursor sets the block of a route or listener cursor
+func setCursor(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("cursors set", flag.ContinueOnError)
+	cursorType := flags.String("type", string(store.RouteCursor), "cursor type, route or listener")
+	source := flags.Uint("source", 0, "source domain ID of the route or domain ID of the listener")
+	destination := flags.Uint("destination", 0, "destination domain ID of the route")
+	block := flags.String("block", "", "block number the cursor is set to")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	blockNumber, ok := new(big.Int).SetString(*block, 10)
+	if !ok {
+		return fmt.Errorf("invalid block %s", *block)
+	}
+
+	return withCursorStore(func(cursorStore *store.CursorStore) error {
+		cursor := &store.Cursor{
+			Type:        store.CursorType(*cursorType),
+			Source:      uint8(*source),
+			Destination: uint8(*destination),
+			Block:       blockNumber,
+		}
+		err := cursorStore.StoreCursor(cursor)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(out, cursor)
+	})
+}
+
+// rewindCursor moves the block of a route or listener cursor back by the given number of blocks
+func rewindCursor(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("cursors rewind", flag.ContinueOnError)
+	cursorType := flags.String("type", string(store.RouteCursor), "cursor type, route or listener")
+	source := flags.Uint("source", 0, "source domain ID of the route or domain ID of the listener")
+	destination := flags.Uint("destination", 0, "destination domain ID of the route")
+	blocks := flags.Uint64("blocks", 0, "number of blocks the cursor is moved back by")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if *blocks == 0 {
+		return fmt.Errorf("number of blocks not set")
+	}
+
+	return withCursorStore(func(cursorStore *store.CursorStore) error {
+		cursor, err := cursorStore.Cursor(store.CursorType(*cursorType), uint8(*source), uint8(*destination))
+		if err != nil {
+			return err
+		}
+
+		cursor.Block.Sub(cursor.Block, new(big.Int).SetUint64(*blocks))
+		if cursor.Block.Sign() < 0 {
+			cursor.Block = big.NewInt(0)
+		}
+		err = cursorStore.StoreCursor(cursor)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(out, cursor)
+	})
+}
+
+// exportCursors writes all route and listener cursors as JSON to the file or output
+func exportCursors(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("cursors export", flag.ContinueOnError)
+	file := flags.String("file", "", "path of the exported JSON file, printed to the output if not set")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	return withCursorStore(func(cursorStore *store.CursorStore) error {
+		cursors, err := cursorStore.Cursors()
+		if err != nil {
+			return err
+		}
+		if *file == "" {
+			return printJSON(out, cursors)
+		}
+
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return printJSON(f, cursors)
+	})
+}
+
+// importCursors stores all cursors from the JSON file created by the export command
+func importCursors(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("cursors import", flag.ContinueOnError)
+	file := flags.String("file", "", "path of the JSON file created by the export command")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("import file not set")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	cursors := []*store.Cursor{}
+	err = json.Unmarshal(data, &cursors)
+	if err != nil {
+		return err
+	}
+
+	return withCursorStore(func(cursorStore *store.CursorStore) error {
+		for _, cursor := range cursors {
+			err := cursorStore.StoreCursor(cursor)
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(out, "Imported %d cursors\n", len(cursors))
+		return nil
+	})
+}
+
+// withCursorStore opens the configured store for the duration of the command.
+// The relayer has to be stopped as LevelDB can be opened by a single process only.
+func withCursorStore(cmd func(cursorStore *store.CursorStore) error) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	db, err := leveldb.OpenFile(cfg.Store.Path, &opt.Options{ErrorIfMissing: true})
+	if err != nil {
+		return fmt.Errorf("failed opening store %s: %w", cfg.Store.Path, err)
+	}
+	defer db.Close()
+
+	return cmd(store.NewCursorStore(db))
+}
@@ -0,0 +1,176 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	evmConfig "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/events"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/metrics"
+)
+
+type ReceiptProofOutput struct {
+	TxHash       common.Hash     `json:"txHash"`
+	BlockHash    common.Hash     `json:"blockHash"`
+	ReceiptsRoot common.Hash     `json:"receiptsRoot"`
+	Key          hexutil.Bytes   `json:"key"`
+	Proof        []hexutil.Bytes `json:"proof"`
+}
+
+type ReceiptsRootProofOutput struct {
+	CurrentSlot *big.Int        `json:"currentSlot"`
+	TargetSlot  *big.Int        `json:"targetSlot"`
+	Proof       []hexutil.Bytes `json:"proof"`
+}
+
+type StorageProofOutput struct {
+	BlockNumber  *big.Int `json:"blockNumber"`
+	AccountProof []string `json:"accountProof"`
+	StorageProof []string `json:"storageProof"`
+}
+
+// proveReceipt prints the receipt proof of the transaction with the given hash
+func proveReceipt(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("prove receipt", flag.ContinueOnError)
+	domainID := flags.Uint("domain", 0, "ID of the domain the transaction was executed on")
+	tx := flags.String("tx", "", "hash of the transaction to prove")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if *tx == "" {
+		return fmt.Errorf("transaction hash not set")
+	}
+
+	config, err := evmConfig.LoadEVMConfig(uint8(*domainID))
+	if err != nil {
+		return err
+	}
+	client, err := evmClient(config)
+	if err != nil {
+		return err
+	}
+
+	txHash := common.HexToHash(*tx)
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return err
+	}
+	receiptProof, err := proof.NewReceiptProver(client, metrics.NewRelayerMetrics()).ReceiptProof(txHash)
+	if err != nil {
+		return err
+	}
+	key, err := rlp.EncodeToBytes(receipt.TransactionIndex)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(out, ReceiptProofOutput{
+		TxHash:       txHash,
+		BlockHash:    receipt.BlockHash,
+		ReceiptsRoot: crypto.Keccak256Hash(receiptProof[0]),
+		Key:          key,
+		Proof:        hexProof(receiptProof),
+	})
+}
+
+// proveReceiptsRoot prints the proof of the target slot receipts root against the current slot block root
+func proveReceiptsRoot(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("prove receipts-root", flag.ContinueOnError)
+	domainID := flags.Uint("domain", 0, "ID of the domain the slots belong to")
+	currentSlot := flags.Uint64("current-slot", 0, "slot of the block root the proof is verified against")
+	targetSlot := flags.Uint64("target-slot", 0, "slot of the receipts root to prove")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	config, err := evmConfig.LoadEVMConfig(uint8(*domainID))
+	if err != nil {
+		return err
+	}
+	beaconClient, archiveBeaconClient, err := beaconClients(ctx, config)
+	if err != nil {
+		return err
+	}
+	prover, err := receiptRootProver(config, beaconClient, archiveBeaconClient)
+	if err != nil {
+		return err
+	}
+
+	current := new(big.Int).SetUint64(*currentSlot)
+	target := new(big.Int).SetUint64(*targetSlot)
+	receiptsRootProof, err := prover.ReceiptsRootProof(ctx, current, target)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(out, ReceiptsRootProofOutput{
+		CurrentSlot: current,
+		TargetSlot:  target,
+		Proof:       hexProof(receiptsRootProof),
+	})
+}
+
+// proveStorage prints the router account and deposit storage proofs of the deposit with the given nonce
+func proveStorage(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("prove storage", flag.ContinueOnError)
+	domainID := flags.Uint("domain", 0, "ID of the domain the deposit was made on")
+	destinationDomainID := flags.Uint("destination", 0, "ID of the deposit destination domain")
+	depositNonce := flags.Uint64("deposit-nonce", 0, "nonce of the deposit to prove")
+	block := flags.Uint64("block", 0, "block number of the proven state")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if *block == 0 {
+		return fmt.Errorf("block number not set")
+	}
+
+	config, err := evmConfig.LoadEVMConfig(uint8(*domainID))
+	if err != nil {
+		return err
+	}
+	client, err := evmClient(config)
+	if err != nil {
+		return err
+	}
+
+	handler := handlers.NewDepositEventHandler(
+		uint8(*domainID), client, common.HexToAddress(config.Router), config.SlotIndex, config.GenericResources, metrics.NewRelayerMetrics(), nil)
+	blockNumber := new(big.Int).SetUint64(*block)
+	accountProof, storageProof, err := handler.Proof(blockNumber, &events.Deposit{
+		DestinationDomainID: uint8(*destinationDomainID),
+		DepositNonce:        *depositNonce,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(out, StorageProofOutput{
+		BlockNumber:  blockNumber,
+		AccountProof: accountProof,
+		StorageProof: storageProof,
+	})
+}
+
+func hexProof(proof [][]byte) []hexutil.Bytes {
+	hexProof := make([]hexutil.Bytes, len(proof))
+	for i, node := range proof {
+		hexProof[i] = node
+	}
+
+	return hexProof
+}
@@ -0,0 +1,165 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor/gas"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor/signAndSend"
+	"github.com/sygmaprotocol/sygma-core/chains/evm/transactor/transaction"
+	evmConfig "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/contracts"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers"
+	evmMessage "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/config"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/metrics"
+)
+
+type HashiRelayOutput struct {
+	MessageID string         `json:"messageId"`
+	To        common.Address `json:"to"`
+	Calldata  hexutil.Bytes  `json:"calldata,omitempty"`
+	TxHash    *common.Hash   `json:"txHash,omitempty"`
+}
+
+// relayHashi builds the hashi message of a yaho MessageDispatched log and submits it to the
+// hashi adapter of the destination domain
+func relayHashi(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("relay hashi", flag.ContinueOnError)
+	sourceDomainID := flags.Uint("source", 0, "ID of the domain the message was dispatched on")
+	destinationDomainID := flags.Uint("destination", 0, "ID of the domain the message is relayed to")
+	tx := flags.String("tx", "", "hash of the transaction that dispatched the message")
+	logIndex := flags.Uint("log-index", 0, "index of the MessageDispatched log in the block")
+	slot := flags.Uint64("slot", 0, "source slot of the state root stored on the destination domain")
+	dryRun := flags.Bool("dry-run", false, "print the transaction calldata without submitting it")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if *tx == "" {
+		return fmt.Errorf("transaction hash not set")
+	}
+	if *slot == 0 {
+		return fmt.Errorf("state root slot not set")
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+	sourceConfig, err := evmConfig.LoadEVMConfig(uint8(*sourceDomainID))
+	if err != nil {
+		return err
+	}
+	destinationConfig, err := evmConfig.LoadEVMConfig(uint8(*destinationDomainID))
+	if err != nil {
+		return err
+	}
+
+	sourceClient, err := evmClient(sourceConfig)
+	if err != nil {
+		return err
+	}
+	beaconClient, archiveBeaconClient, err := beaconClients(ctx, sourceConfig)
+	if err != nil {
+		return err
+	}
+	rootProver, err := receiptRootProver(sourceConfig, beaconClient, archiveBeaconClient)
+	if err != nil {
+		return err
+	}
+	relayerMetrics := metrics.NewRelayerMetrics()
+	handler := handlers.NewHashiEventHandler(
+		uint8(*sourceDomainID),
+		sourceClient,
+		beaconClient,
+		proof.NewReceiptProver(sourceClient, relayerMetrics),
+		rootProver,
+		common.HexToAddress(sourceConfig.Yaho),
+		cfg.ChainIDS,
+		relayerMetrics,
+		nil)
+	msg, err := handler.Message(common.HexToHash(*tx), *logIndex, uint8(*destinationDomainID), new(big.Int).SetUint64(*slot))
+	if err != nil {
+		return err
+	}
+	data := msg.Data.(evmMessage.HashiData)
+
+	destinationClient, err := evmClient(destinationConfig)
+	if err != nil {
+		return err
+	}
+	gasPricer := gas.NewLondonGasPriceClient(destinationClient, &gas.GasPricerOpts{
+		UpperLimitFeePerGas: big.NewInt(destinationConfig.MaxGasPrice),
+		GasPriceFactor:      big.NewFloat(destinationConfig.GasMultiplier),
+	})
+	hashiAddress := common.HexToAddress(destinationConfig.Hashi)
+	hashiAdapter := contracts.NewHashiAdapterContract(
+		hashiAddress,
+		destinationClient,
+		signAndSend.NewSignAndSendTransactor(transaction.NewTransaction, gasPricer, destinationClient))
+
+	calldata, err := hashiAdapter.VerifyAndStoreDispatchedMessageCalldata(
+		data.SrcSlot.Uint64(),
+		data.TxSlot.Uint64(),
+		data.ReceiptRootProof,
+		data.ReceiptRoot,
+		data.ReceiptProof,
+		data.TxIndexRLPEncoded,
+		data.LogIndex,
+	)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		return printJSON(out, HashiRelayOutput{
+			MessageID: msg.ID,
+			To:        hashiAddress,
+			Calldata:  calldata,
+		})
+	}
+
+	if data.DispatchedMessageID != nil {
+		messageHash, err := hashiAdapter.MessageHash(data.DispatchedMessageID)
+		if err != nil {
+			return err
+		}
+		if messageHash != [32]byte{} {
+			return fmt.Errorf("message %s already stored on domain %d", data.DispatchedMessageID, *destinationDomainID)
+		}
+	}
+
+	gasEstimator := executor.NewEVMGasEstimator(destinationClient, destinationConfig.GasLimitMargin, destinationConfig.MaxGasLimit)
+	hash, err := hashiAdapter.VerifyAndStoreDispatchedMessage(
+		data.SrcSlot.Uint64(),
+		data.TxSlot.Uint64(),
+		data.ReceiptRootProof,
+		data.ReceiptRoot,
+		data.ReceiptProof,
+		data.TxIndexRLPEncoded,
+		data.LogIndex,
+		transactor.TransactOptions{
+			GasLimit: gasEstimator.EstimateGas(&hashiAddress, calldata, executor.HASHI_GAS_COST),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(out, HashiRelayOutput{
+		MessageID: msg.ID,
+		To:        hashiAddress,
+		TxHash:    hash,
+	})
+}
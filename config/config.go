@@ -10,6 +10,7 @@ const PREFIX = "INCLUSION_PROVER"
 type Config struct {
 	Observability *Observability   `env_config:"observability"`
 	Store         *Store           `env_config:"store"`
+	Admin         *Admin           `env_config:"admin"`
 	Domains       map[uint8]string `required:"true"`
 	ChainIDS      map[uint8]uint64 `required:"true"`
 }
@@ -20,6 +21,12 @@ type Observability struct {
 	HealthPort uint16 `default:"9001" split_words:"true"`
 }
 
+// Admin endpoints are started only if the token is set
+type Admin struct {
+	Port  uint16 `default:"9002"`
+	Token string
+}
+
 type Store struct {
 	Path string `default:"./lvldbdata"`
 }
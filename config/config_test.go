@@ -51,6 +51,9 @@ func (s *ConfigTestSuite) Test_LoadConfig_DefaultValues() {
 		Store: &config.Store{
 			Path: "./lvldbdata",
 		},
+		Admin: &config.Admin{
+			Port: 9002,
+		},
 		Domains:  domains,
 		ChainIDS: chainIDS,
 	})
@@ -61,6 +64,8 @@ func (s *ConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 	os.Setenv("INCLUSION_PROVER_OBSERVABILITY_LOG_FILE", "out2.log")
 	os.Setenv("INCLUSION_PROVER_OBSERVABILITY_HEALTH_PORT", "9003")
 	os.Setenv("INCLUSION_PROVER_STORE_PATH", "./custom_path")
+	os.Setenv("INCLUSION_PROVER_ADMIN_PORT", "9004")
+	os.Setenv("INCLUSION_PROVER_ADMIN_TOKEN", "secret")
 	os.Setenv("INCLUSION_PROVER_DOMAINS", "1:evm,2:evm")
 	os.Setenv("INCLUSION_PROVER_CHAINIDS", "1:3,2:6")
 
@@ -82,6 +87,10 @@ func (s *ConfigTestSuite) Test_LoadEVMConfig_SuccessfulLoad() {
 		Store: &config.Store{
 			Path: "./custom_path",
 		},
+		Admin: &config.Admin{
+			Port:  9004,
+			Token: "secret",
+		},
 		Domains:  domains,
 		ChainIDS: chainIDS,
 	})
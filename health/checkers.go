@@ -0,0 +1,132 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package health
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sygmaprotocol/sygma-core/store"
+)
+
+type HeaderFetcher interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// RPCHeadChecker checks that the head block of the RPC node is not older than the max age
+type RPCHeadChecker struct {
+	client HeaderFetcher
+	maxAge time.Duration
+}
+
+func NewRPCHeadChecker(client HeaderFetcher, maxAge time.Duration) *RPCHeadChecker {
+	return &RPCHeadChecker{
+		client: client,
+		maxAge: maxAge,
+	}
+}
+
+func (c *RPCHeadChecker) Check(ctx context.Context) error {
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	age := time.Since(time.Unix(int64(head.Time), 0))
+	if age > c.maxAge {
+		return fmt.Errorf("head block %s is %s old", head.Number, age.Truncate(time.Second))
+	}
+	return nil
+}
+
+type SyncStatusFetcher interface {
+	NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error)
+}
+
+// BeaconSyncChecker checks that the beacon node is synced
+type BeaconSyncChecker struct {
+	client       SyncStatusFetcher
+	slotDuration time.Duration
+}
+
+func NewBeaconSyncChecker(client SyncStatusFetcher, slotDuration time.Duration) *BeaconSyncChecker {
+	return &BeaconSyncChecker{
+		client:       client,
+		slotDuration: slotDuration,
+	}
+}
+
+func (c *BeaconSyncChecker) Check(ctx context.Context) error {
+	res, err := c.client.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		return err
+	}
+
+	if res.Data.IsSyncing {
+		return fmt.Errorf(
+			"beacon node is syncing, sync distance is %d slots (%s)",
+			res.Data.SyncDistance, time.Duration(res.Data.SyncDistance)*c.slotDuration)
+	}
+	return nil
+}
+
+type VersionFetcher interface {
+	NodeVersion(ctx context.Context, opts *api.NodeVersionOpts) (*api.Response[string], error)
+}
+
+// ReachabilityChecker checks that the beacon node responds to requests
+type ReachabilityChecker struct {
+	client VersionFetcher
+}
+
+func NewReachabilityChecker(client VersionFetcher) *ReachabilityChecker {
+	return &ReachabilityChecker{
+		client: client,
+	}
+}
+
+func (c *ReachabilityChecker) Check(ctx context.Context) error {
+	_, err := c.client.NodeVersion(ctx, &api.NodeVersionOpts{})
+	return err
+}
+
+// StoreChecker checks that the store is writable by writing and reading back the current time
+type StoreChecker struct {
+	db   store.KeyValueReaderWriter
+	lock sync.Mutex
+}
+
+func NewStoreChecker(db store.KeyValueReaderWriter) *StoreChecker {
+	return &StoreChecker{
+		db: db,
+	}
+}
+
+func (c *StoreChecker) Check(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := []byte("health:check")
+	value := []byte(time.Now().UTC().Format(time.RFC3339Nano))
+	err := c.db.SetByKey(key, value)
+	if err != nil {
+		return err
+	}
+
+	storedValue, err := c.db.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storedValue, value) {
+		return fmt.Errorf("stored health check value does not match")
+	}
+	return nil
+}
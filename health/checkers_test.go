@@ -0,0 +1,86 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package health_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/health"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type CheckersTestSuite struct {
+	suite.Suite
+
+	mockHeaderFetcher     *mock.MockHeaderFetcher
+	mockSyncStatusFetcher *mock.MockSyncStatusFetcher
+	mockStore             *mock.MockKeyValueReaderWriter
+}
+
+func TestRunCheckersTestSuite(t *testing.T) {
+	suite.Run(t, new(CheckersTestSuite))
+}
+
+func (s *CheckersTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockHeaderFetcher = mock.NewMockHeaderFetcher(ctrl)
+	s.mockSyncStatusFetcher = mock.NewMockSyncStatusFetcher(ctrl)
+	s.mockStore = mock.NewMockKeyValueReaderWriter(ctrl)
+}
+
+func (s *CheckersTestSuite) Test_RPCHeadChecker_StaleHead() {
+	s.mockHeaderFetcher.EXPECT().HeaderByNumber(gomock.Any(), nil).Return(&types.Header{
+		Number: big.NewInt(100),
+		Time:   uint64(time.Now().Add(-time.Minute * 5).Unix()),
+	}, nil)
+
+	err := health.NewRPCHeadChecker(s.mockHeaderFetcher, time.Minute).Check(context.Background())
+
+	s.NotNil(err)
+}
+
+func (s *CheckersTestSuite) Test_RPCHeadChecker_FreshHead() {
+	s.mockHeaderFetcher.EXPECT().HeaderByNumber(gomock.Any(), nil).Return(&types.Header{
+		Number: big.NewInt(100),
+		Time:   uint64(time.Now().Unix()),
+	}, nil)
+
+	err := health.NewRPCHeadChecker(s.mockHeaderFetcher, time.Minute).Check(context.Background())
+
+	s.Nil(err)
+}
+
+func (s *CheckersTestSuite) Test_BeaconSyncChecker_Syncing() {
+	s.mockSyncStatusFetcher.EXPECT().NodeSyncing(gomock.Any(), gomock.Any()).Return(&api.Response[*apiv1.SyncState]{
+		Data: &apiv1.SyncState{IsSyncing: true, SyncDistance: 10},
+	}, nil)
+
+	err := health.NewBeaconSyncChecker(s.mockSyncStatusFetcher, time.Second*5).Check(context.Background())
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "50s")
+}
+
+func (s *CheckersTestSuite) Test_StoreChecker_Writable() {
+	var stored []byte
+	s.mockStore.EXPECT().SetByKey([]byte("health:check"), gomock.Any()).DoAndReturn(func(_ []byte, value []byte) error {
+		stored = value
+		return nil
+	})
+	s.mockStore.EXPECT().GetByKey([]byte("health:check")).DoAndReturn(func(_ []byte) ([]byte, error) {
+		return stored, nil
+	})
+
+	err := health.NewStoreChecker(s.mockStore).Check(context.Background())
+
+	s.Nil(err)
+}
@@ -4,18 +4,161 @@
 package health
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
-// StartHealthEndpoint starts /health endpoint on provided port that returns ok on invocation
-func StartHealthEndpoint(port uint16) {
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		_, _ = w.Write([]byte("ok"))
-	})
+const (
+	STATUS_UP   = "up"
+	STATUS_DOWN = "down"
+)
+
+// Checker checks the status of a single relayer component
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+// Health runs registered component checkers. Liveness checkers report if the relayer
+// process is able to work at all, while readiness checkers additionally report if
+// external dependencies are usable.
+type Health struct {
+	liveness  map[string]Checker
+	readiness map[string]Checker
+	timeout   time.Duration
+	lock      sync.RWMutex
+}
+
+func NewHealth(timeout time.Duration) *Health {
+	return &Health{
+		liveness:  make(map[string]Checker),
+		readiness: make(map[string]Checker),
+		timeout:   timeout,
+	}
+}
+
+// AddLivenessCheck registers the checker for both /health and /ready endpoints
+func (h *Health) AddLivenessCheck(name string, checker Checker) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.liveness[name] = checker
+}
+
+// AddReadinessCheck registers the checker for the /ready endpoint
+func (h *Health) AddReadinessCheck(name string, checker Checker) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.readiness[name] = checker
+}
+
+// Liveness runs liveness checkers
+func (h *Health) Liveness(ctx context.Context) *Report {
+	h.lock.RLock()
+	checkers := make(map[string]Checker, len(h.liveness))
+	for name, checker := range h.liveness {
+		checkers[name] = checker
+	}
+	h.lock.RUnlock()
+
+	return h.report(ctx, checkers)
+}
+
+// Readiness runs liveness and readiness checkers
+func (h *Health) Readiness(ctx context.Context) *Report {
+	h.lock.RLock()
+	checkers := make(map[string]Checker, len(h.liveness)+len(h.readiness))
+	for name, checker := range h.liveness {
+		checkers[name] = checker
+	}
+	for name, checker := range h.readiness {
+		checkers[name] = checker
+	}
+	h.lock.RUnlock()
+
+	return h.report(ctx, checkers)
+}
+
+// report runs checkers concurrently and reports the relayer as down if any of the components is down
+func (h *Health) report(ctx context.Context, checkers map[string]Checker) *Report {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	report := &Report{
+		Status:     STATUS_UP,
+		Components: make(map[string]ComponentStatus, len(checkers)),
+	}
+	lock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+			status := ComponentStatus{Status: STATUS_UP}
+			err := checker.Check(ctx)
+			if err != nil {
+				status = ComponentStatus{Status: STATUS_DOWN, Error: err.Error()}
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			report.Components[name] = status
+			if err != nil {
+				report.Status = STATUS_DOWN
+			}
+		}(name, checker)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// LivenessHandler returns the liveness report as JSON with 503 status if any of the components is down
+func (h *Health) LivenessHandler() http.Handler {
+	return h.handler(h.Liveness)
+}
+
+// ReadinessHandler returns the readiness report as JSON with 503 status if any of the components is down
+func (h *Health) ReadinessHandler() http.Handler {
+	return h.handler(h.Readiness)
+}
+
+func (h *Health) handler(report func(ctx context.Context) *Report) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := report(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if res.Status != STATUS_UP {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// StartHealthEndpoint starts /health (liveness) and /ready (readiness) endpoints on provided port
+// that return the status of registered components, and /metrics endpoint served by the metrics handler
+func StartHealthEndpoint(port uint16, h *Health, metricsHandler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/health", h.LivenessHandler())
+	mux.Handle("/ready", h.ReadinessHandler())
+	mux.Handle("/metrics", metricsHandler)
 
-	_ = http.ListenAndServe(fmt.Sprintf(":%d", port), nil)
-	log.Info().Msgf("started /health endpoint on port %d", port)
+	log.Info().Msgf("Starting /health, /ready and /metrics endpoints on port %d", port)
+	err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+	if err != nil {
+		log.Err(err).Msgf("Health endpoint stopped")
+	}
 }
@@ -0,0 +1,96 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package health_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/health"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type HealthTestSuite struct {
+	suite.Suite
+
+	health         *health.Health
+	storeChecker   *mock.MockChecker
+	rpcChecker     *mock.MockChecker
+	balanceChecker *mock.MockChecker
+}
+
+func TestRunHealthTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthTestSuite))
+}
+
+func (s *HealthTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.storeChecker = mock.NewMockChecker(ctrl)
+	s.rpcChecker = mock.NewMockChecker(ctrl)
+	s.balanceChecker = mock.NewMockChecker(ctrl)
+	s.health = health.NewHealth(time.Second)
+	s.health.AddLivenessCheck("store", s.storeChecker)
+	s.health.AddReadinessCheck("1.rpc", s.rpcChecker)
+	s.health.AddReadinessCheck("1.balance", s.balanceChecker)
+}
+
+func (s *HealthTestSuite) request(handler http.Handler) (int, *health.Report) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	report := &health.Report{}
+	err := json.Unmarshal(recorder.Body.Bytes(), report)
+	s.Nil(err)
+	return recorder.Code, report
+}
+
+func (s *HealthTestSuite) Test_Liveness_RunsOnlyLivenessChecks() {
+	s.storeChecker.EXPECT().Check(gomock.Any()).Return(nil)
+
+	code, report := s.request(s.health.LivenessHandler())
+
+	s.Equal(code, http.StatusOK)
+	s.Equal(report, &health.Report{
+		Status: health.STATUS_UP,
+		Components: map[string]health.ComponentStatus{
+			"store": {Status: health.STATUS_UP},
+		},
+	})
+}
+
+func (s *HealthTestSuite) Test_Readiness_AllComponentsUp() {
+	s.storeChecker.EXPECT().Check(gomock.Any()).Return(nil)
+	s.rpcChecker.EXPECT().Check(gomock.Any()).Return(nil)
+	s.balanceChecker.EXPECT().Check(gomock.Any()).Return(nil)
+
+	code, report := s.request(s.health.ReadinessHandler())
+
+	s.Equal(code, http.StatusOK)
+	s.Equal(report.Status, health.STATUS_UP)
+	s.Equal(len(report.Components), 3)
+}
+
+func (s *HealthTestSuite) Test_Readiness_ComponentDown() {
+	s.storeChecker.EXPECT().Check(gomock.Any()).Return(nil)
+	s.rpcChecker.EXPECT().Check(gomock.Any()).Return(nil)
+	s.balanceChecker.EXPECT().Check(gomock.Any()).Return(fmt.Errorf("balance too low"))
+
+	code, report := s.request(s.health.ReadinessHandler())
+
+	s.Equal(code, http.StatusServiceUnavailable)
+	s.Equal(report, &health.Report{
+		Status: health.STATUS_DOWN,
+		Components: map[string]health.ComponentStatus{
+			"store":     {Status: health.STATUS_UP},
+			"1.rpc":     {Status: health.STATUS_UP},
+			"1.balance": {Status: health.STATUS_DOWN, Error: "balance too low"},
+		},
+	})
+}
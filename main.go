@@ -28,19 +28,33 @@ import (
 	"github.com/sygmaprotocol/sygma-core/relayer/message"
 	coreStore "github.com/sygmaprotocol/sygma-core/store"
 	"github.com/sygmaprotocol/sygma-core/store/lvldb"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/admin"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
 	evmConfig "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/config"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/contracts"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/executor"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers"
 	evmMessage "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/message"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/proof"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/cli"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/config"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/health"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/metrics"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
 )
 
+const HEALTH_CHECK_TIMEOUT = time.Second * 10
+
 func main() {
+	if len(os.Args) > 1 {
+		err := cli.Run(context.Background(), os.Args[1:], os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(err)
@@ -54,7 +68,8 @@ func main() {
 
 	log.Info().Msg("Loaded configuration")
 
-	go health.StartHealthEndpoint(cfg.Observability.HealthPort)
+	relayerMetrics := metrics.NewRelayerMetrics()
+	relayerHealth := health.NewHealth(HEALTH_CHECK_TIMEOUT)
 	var db *lvldb.LVLDB
 	for {
 		db, err = lvldb.NewLvlDB(cfg.Store.Path)
@@ -66,10 +81,17 @@ func main() {
 			break
 		}
 	}
+	relayerHealth.AddLivenessCheck("store", health.NewStoreChecker(db))
 	latestBlockStore := store.NewBlockStore(db)
 	blockStore := coreStore.NewBlockStore(db)
+	executionStore := store.NewExecutionStore(db)
+	retryStore := store.NewRetryStore(db)
 
 	msgChan := make(chan []*message.Message)
+	if cfg.Admin.Token != "" {
+		go admin.StartAdminEndpoint(
+			cfg.Admin.Port, admin.NewAdmin(cfg.Admin.Token, latestBlockStore, retryStore, executionStore, msgChan))
+	}
 	chains := make(map[uint8]relayer.RelayedChain)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -91,6 +113,12 @@ func main() {
 				if err != nil {
 					panic(err)
 				}
+				relayerHealth.AddReadinessCheck(
+					fmt.Sprintf("%d.rpc", id), health.NewRPCHeadChecker(client, time.Duration(config.MaxHeadAge)*time.Second))
+				balanceMonitor := executor.NewEVMBalanceMonitor(
+					id, client, relayerMetrics, config.MinBalance.Int, config.BalanceFloor.Int)
+				go balanceMonitor.Run(ctx, time.Duration(config.BalanceCheckInterval)*time.Second)
+				relayerHealth.AddReadinessCheck(fmt.Sprintf("%d.balance", id), balanceMonitor)
 
 				startBlock, err := blockStore.GetStartBlock(
 					id,
@@ -119,7 +147,7 @@ func main() {
 						client,
 						eventHandlers,
 						blockStore,
-						&metrics.RelayerMetrics{},
+						relayerMetrics,
 						id,
 						time.Duration(config.BlockRetryInterval)*time.Second,
 						big.NewInt(config.BlockConfirmations),
@@ -130,6 +158,10 @@ func main() {
 				messageHandler.RegisterMessageHandler(evmMessage.EVMTransferMessage, &evmMessage.TransferHandler{})
 				messageHandler.RegisterMessageHandler(evmMessage.HashiMessage, &evmMessage.HashiMessageHandler{})
 				if config.Yaho != "" || config.Router != "" {
+					chainSpec, err := evmConfig.LoadChainSpec(config.Spec, config.SpecPath)
+					if err != nil {
+						panic(err)
+					}
 					beaconClient, err := http.New(ctx,
 						http.WithAddress(config.BeaconEndpoint),
 						http.WithLogLevel(logLevel),
@@ -139,7 +171,10 @@ func main() {
 					if err != nil {
 						panic(err)
 					}
-					beaconProvider := beaconClient.(*http.Service)
+					beaconProvider := metrics.NewBeaconClient(
+						beacon.NewClient(beaconClient.(*http.Service), time.Minute*15), "beacon", relayerMetrics)
+					relayerHealth.AddReadinessCheck(
+						fmt.Sprintf("%d.beacon", id), health.NewBeaconSyncChecker(beaconClient.(*http.Service), chainSpec.SlotDuration()))
 
 					archiveBeaconClinet, err := http.New(ctx,
 						http.WithAddress(config.ArchiveBeaconEndpoint),
@@ -150,9 +185,19 @@ func main() {
 					if err != nil {
 						panic(err)
 					}
-					archiveBeaconProvider := archiveBeaconClinet.(*http.Service)
-					receiptProver := proof.NewReceiptProver(client)
-					rootProver := proof.NewReceiptRootProver(beaconProvider, archiveBeaconProvider, config.Spec)
+					archiveBeaconProvider := metrics.NewBeaconClient(
+						beacon.NewClient(archiveBeaconClinet.(*http.Service), time.Minute*30), "archive", relayerMetrics)
+					relayerHealth.AddReadinessCheck(
+						fmt.Sprintf("%d.archive", id), health.NewReachabilityChecker(archiveBeaconClinet.(*http.Service)))
+					receiptProver := proof.NewReceiptProver(client, relayerMetrics)
+					var stateStore proof.BeaconStateStore
+					if config.StateStorePath != "" {
+						stateStore, err = store.NewBeaconStateStore(config.StateStorePath, string(config.Spec), config.StateStoreSize)
+						if err != nil {
+							panic(err)
+						}
+					}
+					rootProver := proof.NewReceiptRootProver(beaconProvider, archiveBeaconProvider, chainSpec, stateStore)
 
 					stateRootEventHandlers := make([]evmMessage.EventHandler, 0)
 					if config.Yaho != "" {
@@ -160,7 +205,7 @@ func main() {
 						stateRootEventHandlers = append(
 							stateRootEventHandlers,
 							handlers.NewHashiEventHandler(
-								id, client, beaconProvider, receiptProver, rootProver, yahoAddress, cfg.ChainIDS, msgChan),
+								id, client, beaconProvider, receiptProver, rootProver, yahoAddress, cfg.ChainIDS, relayerMetrics, msgChan),
 						)
 
 					}
@@ -169,25 +214,71 @@ func main() {
 						stateRootEventHandlers = append(
 							stateRootEventHandlers,
 							handlers.NewDepositEventHandler(
-								id, client, routerAddress, config.SlotIndex, config.GenericResources, msgChan),
+								id, client, routerAddress, config.SlotIndex, config.GenericResources, relayerMetrics, msgChan),
 						)
 					}
 					messageHandler.RegisterMessageHandler(
 						evmMessage.EVMStateRootMessage,
-						evmMessage.NewStateRootHandler(id, stateRootEventHandlers, beaconProvider, latestBlockStore, new(big.Int).Set(startBlock)))
+						evmMessage.NewStateRootHandler(id, stateRootEventHandlers, beaconProvider, latestBlockStore, relayerMetrics, new(big.Int).Set(startBlock)))
 				}
 
 				gasPricer := gas.NewLondonGasPriceClient(client, &gas.GasPricerOpts{
 					UpperLimitFeePerGas: big.NewInt(config.MaxGasPrice),
 					GasPriceFactor:      big.NewFloat(config.GasMultiplier),
 				})
-				t := monitored.NewMonitoredTransactor(transaction.NewTransaction, gasPricer, client, big.NewInt(config.MaxGasPrice), big.NewInt(config.GasIncreasePercentage))
-				go t.Monitor(ctx, time.Minute*3, time.Minute*10, time.Minute)
-				evmExecutor := executor.NewEVMExecutor(
-					id,
-					contracts.NewExecutorContract(common.HexToAddress(config.Executor), client, t),
-					contracts.NewHashiAdapterContract(common.HexToAddress(config.Hashi), client, t),
-				)
+				gasEstimator := executor.NewEVMGasEstimator(client, config.GasLimitMargin, config.MaxGasLimit)
+				var evmExecutor *executor.EVMExecutor
+				var multicall executor.MulticallContract
+				if config.DryRun {
+					log.Info().Uint8("domainID", id).Msg("Running executor in dry-run mode")
+					t := executor.NewShadowTransactor(id, client)
+					if config.Multicall != "" {
+						multicall = contracts.NewMulticallContract(common.HexToAddress(config.Multicall), client, t)
+					}
+					evmExecutor = executor.NewEVMExecutor(
+						id,
+						contracts.NewExecutorContract(common.HexToAddress(config.Executor), client, t),
+						contracts.NewHashiAdapterContract(common.HexToAddress(config.Hashi), client, t),
+						multicall,
+						gasEstimator,
+						nil,
+						nil,
+						nil,
+						relayerMetrics,
+						config.MaxGasLimit,
+						true,
+					)
+				} else {
+					t := monitored.NewMonitoredTransactor(transaction.NewTransaction, gasPricer, client, big.NewInt(config.MaxGasPrice), big.NewInt(config.GasIncreasePercentage))
+					go t.Monitor(ctx, time.Minute*3, time.Minute*10, time.Minute)
+					if config.Multicall != "" {
+						multicall = contracts.NewMulticallContract(common.HexToAddress(config.Multicall), client, t)
+					}
+					retryQueue := executor.NewEVMRetryQueue(
+						id,
+						retryStore,
+						config.MaxExecutionAttempts,
+						time.Duration(config.RetryBackoff)*time.Second,
+						time.Duration(config.MaxRetryBackoff)*time.Second)
+					evmExecutor = executor.NewEVMExecutor(
+						id,
+						contracts.NewExecutorContract(common.HexToAddress(config.Executor), client, t),
+						contracts.NewHashiAdapterContract(common.HexToAddress(config.Hashi), client, t),
+						multicall,
+						gasEstimator,
+						executor.NewEVMExecutionWatcher(id, client, executionStore, relayerMetrics, time.Duration(config.BlockRetryInterval)*time.Second),
+						retryQueue,
+						balanceMonitor,
+						relayerMetrics,
+						config.MaxGasLimit,
+						false,
+					)
+					err = evmExecutor.Resume()
+					if err != nil {
+						panic(err)
+					}
+					go retryQueue.Run(ctx, time.Duration(config.BlockRetryInterval)*time.Second, evmExecutor.Retry)
+				}
 				chain := evm.NewEVMChain(evmListener, messageHandler, evmExecutor, id, startBlock)
 				chains[id] = chain
 			}
@@ -198,6 +289,9 @@ func main() {
 		}
 	}
 
+	// started after all checkers are registered so the relayer isn't healthy before its components are set up
+	go health.StartHealthEndpoint(cfg.Observability.HealthPort, relayerHealth, relayerMetrics.Handler())
+
 	r := relayer.NewRelayer(chains)
 	go r.Start(ctx, msgChan)
 
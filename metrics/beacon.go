@@ -0,0 +1,69 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+)
+
+type BeaconProvider interface {
+	BeaconBlockHeader(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)
+	SignedBeaconBlock(ctx context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error)
+	SignedBeaconBlockSSZ(ctx context.Context, blockID string) (*beacon.SSZResponse, error)
+	BeaconStateSSZ(ctx context.Context, stateID string) (*beacon.SSZResponse, error)
+}
+
+type BeaconMetrics interface {
+	TrackBeaconRequest(client string, method string, err error)
+}
+
+// BeaconClient wraps the beacon provider and tracks request counts and errors under the client name
+type BeaconClient struct {
+	provider BeaconProvider
+	name     string
+	metrics  BeaconMetrics
+}
+
+func NewBeaconClient(provider BeaconProvider, name string, metrics BeaconMetrics) *BeaconClient {
+	return &BeaconClient{
+		provider: provider,
+		name:     name,
+		metrics:  metrics,
+	}
+}
+
+func (c *BeaconClient) BeaconBlockHeader(
+	ctx context.Context,
+	opts *api.BeaconBlockHeaderOpts,
+) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	resp, err := c.provider.BeaconBlockHeader(ctx, opts)
+	c.metrics.TrackBeaconRequest(c.name, "BeaconBlockHeader", err)
+	return resp, err
+}
+
+func (c *BeaconClient) SignedBeaconBlock(
+	ctx context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+	resp, err := c.provider.SignedBeaconBlock(ctx, opts)
+	c.metrics.TrackBeaconRequest(c.name, "SignedBeaconBlock", err)
+	return resp, err
+}
+
+func (c *BeaconClient) SignedBeaconBlockSSZ(ctx context.Context, blockID string) (*beacon.SSZResponse, error) {
+	resp, err := c.provider.SignedBeaconBlockSSZ(ctx, blockID)
+	c.metrics.TrackBeaconRequest(c.name, "SignedBeaconBlockSSZ", err)
+	return resp, err
+}
+
+func (c *BeaconClient) BeaconStateSSZ(ctx context.Context, stateID string) (*beacon.SSZResponse, error) {
+	resp, err := c.provider.BeaconStateSSZ(ctx, stateID)
+	c.metrics.TrackBeaconRequest(c.name, "BeaconStateSSZ", err)
+	return resp, err
+}
@@ -5,12 +5,192 @@ package metrics
 
 import (
 	"math/big"
+	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
 )
 
-type RelayerMetrics struct{}
+const NAMESPACE = "inclusion_prover"
+
+type RelayerMetrics struct {
+	registry *prometheus.Registry
+
+	blockLag            *prometheus.GaugeVec
+	receiptTrieCache    *prometheus.CounterVec
+	stateRootMessages   *prometheus.CounterVec
+	depositsFound       *prometheus.CounterVec
+	hashiMessagesFound  *prometheus.CounterVec
+	proofLatency        *prometheus.HistogramVec
+	beaconRequests      *prometheus.CounterVec
+	beaconRequestErrors *prometheus.CounterVec
+	executionsSubmitted *prometheus.CounterVec
+	executionsFailed    *prometheus.CounterVec
+	executionGasSpent   *prometheus.CounterVec
+	signerBalance       *prometheus.GaugeVec
+	executionPaused     *prometheus.GaugeVec
+}
+
+// NewRelayerMetrics creates relayer metrics registered on a dedicated prometheus registry
+func NewRelayerMetrics() *RelayerMetrics {
+	t := &RelayerMetrics{
+		registry: prometheus.NewRegistry(),
+		blockLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Name:      "block_lag",
+			Help:      "Number of blocks between the chain head and the last processed block",
+		}, []string{"domainID"}),
+		receiptTrieCache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "receipt_trie_cache_total",
+			Help:      "Number of receipt trie cache lookups by result",
+		}, []string{"result"}),
+		stateRootMessages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "state_root_messages_total",
+			Help:      "Number of state root messages received",
+		}, []string{"domainID", "sourceDomainID"}),
+		depositsFound: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "deposits_found_total",
+			Help:      "Number of deposits found on the source domain",
+		}, []string{"domainID", "destinationDomainID"}),
+		hashiMessagesFound: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "hashi_messages_found_total",
+			Help:      "Number of hashi messages found on the source domain",
+		}, []string{"domainID", "destinationDomainID"}),
+		proofLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Name:      "proof_generation_seconds",
+			Help:      "Duration of proof generation by proof type",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600},
+		}, []string{"type"}),
+		beaconRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "beacon_requests_total",
+			Help:      "Number of requests sent to beacon nodes",
+		}, []string{"client", "method"}),
+		beaconRequestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "beacon_request_errors_total",
+			Help:      "Number of failed requests sent to beacon nodes",
+		}, []string{"client", "method"}),
+		executionsSubmitted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "executions_submitted_total",
+			Help:      "Number of proposals submitted for execution",
+		}, []string{"domainID"}),
+		executionsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "executions_failed_total",
+			Help:      "Number of proposals whose execution failed or was dropped",
+		}, []string{"domainID"}),
+		executionGasSpent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "execution_gas_spent_total",
+			Help:      "Gas used by execution transactions",
+		}, []string{"domainID"}),
+		signerBalance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Name:      "signer_balance_wei",
+			Help:      "Balance of the relayer signer account",
+		}, []string{"domainID"}),
+		executionPaused: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Name:      "execution_paused",
+			Help:      "Set to 1 while execution is paused because of the low signer balance",
+		}, []string{"domainID"}),
+	}
+	t.registry.MustRegister(
+		t.blockLag,
+		t.receiptTrieCache,
+		t.stateRootMessages,
+		t.depositsFound,
+		t.hashiMessagesFound,
+		t.proofLatency,
+		t.beaconRequests,
+		t.beaconRequestErrors,
+		t.executionsSubmitted,
+		t.executionsFailed,
+		t.executionGasSpent,
+		t.signerBalance,
+		t.executionPaused,
+	)
+	return t
+}
+
+// Handler returns the http handler that exposes metrics in the prometheus format
+func (t *RelayerMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(t.registry, promhttp.HandlerOpts{})
+}
 
 func (t *RelayerMetrics) TrackBlockDelta(domainID uint8, head *big.Int, current *big.Int) {
-	log.Trace().Uint8("domainID", domainID).Msgf("Block delta is %d", new(big.Int).Sub(head, current))
+	delta := new(big.Int).Sub(head, current)
+	log.Trace().Uint8("domainID", domainID).Msgf("Block delta is %d", delta)
+	lag, _ := new(big.Float).SetInt(delta).Float64()
+	t.blockLag.WithLabelValues(domainLabel(domainID)).Set(lag)
+}
+
+func (t *RelayerMetrics) TrackReceiptTrieCacheHit() {
+	t.receiptTrieCache.WithLabelValues("hit").Inc()
+}
+
+func (t *RelayerMetrics) TrackReceiptTrieCacheMiss() {
+	t.receiptTrieCache.WithLabelValues("miss").Inc()
+}
+
+func (t *RelayerMetrics) TrackStateRootMessage(domainID uint8, sourceDomainID uint8) {
+	t.stateRootMessages.WithLabelValues(domainLabel(domainID), domainLabel(sourceDomainID)).Inc()
+}
+
+func (t *RelayerMetrics) TrackDepositsFound(domainID uint8, destinationDomainID uint8, count int) {
+	t.depositsFound.WithLabelValues(domainLabel(domainID), domainLabel(destinationDomainID)).Add(float64(count))
+}
+
+func (t *RelayerMetrics) TrackHashiMessagesFound(domainID uint8, destinationDomainID uint8, count int) {
+	t.hashiMessagesFound.WithLabelValues(domainLabel(domainID), domainLabel(destinationDomainID)).Add(float64(count))
+}
+
+func (t *RelayerMetrics) TrackProofLatency(proofType string, duration time.Duration) {
+	t.proofLatency.WithLabelValues(proofType).Observe(duration.Seconds())
+}
+
+func (t *RelayerMetrics) TrackBeaconRequest(client string, method string, err error) {
+	t.beaconRequests.WithLabelValues(client, method).Inc()
+	if err != nil {
+		t.beaconRequestErrors.WithLabelValues(client, method).Inc()
+	}
+}
+
+func (t *RelayerMetrics) TrackExecutionsSubmitted(domainID uint8, count int) {
+	t.executionsSubmitted.WithLabelValues(domainLabel(domainID)).Add(float64(count))
+}
+
+func (t *RelayerMetrics) TrackExecutionsFailed(domainID uint8, count int) {
+	t.executionsFailed.WithLabelValues(domainLabel(domainID)).Add(float64(count))
+}
+
+func (t *RelayerMetrics) TrackGasSpent(domainID uint8, gasUsed uint64) {
+	t.executionGasSpent.WithLabelValues(domainLabel(domainID)).Add(float64(gasUsed))
+}
+
+func (t *RelayerMetrics) TrackSignerBalance(domainID uint8, balance *big.Int) {
+	value, _ := new(big.Float).SetInt(balance).Float64()
+	t.signerBalance.WithLabelValues(domainLabel(domainID)).Set(value)
+}
+
+func (t *RelayerMetrics) TrackExecutionPaused(domainID uint8, paused bool) {
+	value := 0.0
+	if paused {
+		value = 1
+	}
+	t.executionPaused.WithLabelValues(domainLabel(domainID)).Set(value)
+}
+
+func domainLabel(domainID uint8) string {
+	return strconv.Itoa(int(domainID))
 }
@@ -0,0 +1,54 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package metrics_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/metrics"
+)
+
+type RelayerMetricsTestSuite struct {
+	suite.Suite
+
+	metrics *metrics.RelayerMetrics
+}
+
+func TestRunRelayerMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerMetricsTestSuite))
+}
+
+func (s *RelayerMetricsTestSuite) SetupTest() {
+	s.metrics = metrics.NewRelayerMetrics()
+}
+
+func (s *RelayerMetricsTestSuite) scrape() string {
+	recorder := httptest.NewRecorder()
+	s.metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	s.Equal(recorder.Code, http.StatusOK)
+	return recorder.Body.String()
+}
+
+func (s *RelayerMetricsTestSuite) Test_Handler_ExportsTrackedMetrics() {
+	s.metrics.TrackBlockDelta(1, big.NewInt(110), big.NewInt(100))
+	s.metrics.TrackDepositsFound(1, 2, 3)
+	s.metrics.TrackBeaconRequest("archive", "BeaconState", nil)
+	s.metrics.TrackBeaconRequest("archive", "BeaconState", fmt.Errorf("error"))
+	s.metrics.TrackExecutionsSubmitted(2, 4)
+	s.metrics.TrackGasSpent(2, 50000)
+
+	out := s.scrape()
+
+	s.Contains(out, `inclusion_prover_block_lag{domainID="1"} 10`)
+	s.Contains(out, `inclusion_prover_deposits_found_total{destinationDomainID="2",domainID="1"} 3`)
+	s.Contains(out, `inclusion_prover_beacon_requests_total{client="archive",method="BeaconState"} 2`)
+	s.Contains(out, `inclusion_prover_beacon_request_errors_total{client="archive",method="BeaconState"} 1`)
+	s.Contains(out, `inclusion_prover_executions_submitted_total{domainID="2"} 4`)
+	s.Contains(out, `inclusion_prover_execution_gas_spent_total{domainID="2"} 50000`)
+}
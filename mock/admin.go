@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./admin/admin.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./admin/admin.go -destination=./mock/admin.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	big "math/big"
+	reflect "reflect"
+
+	store "github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRouteBlockStorer is a mock of RouteBlockStorer interface.
+type MockRouteBlockStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRouteBlockStorerMockRecorder
+}
+
+// MockRouteBlockStorerMockRecorder is the mock recorder for MockRouteBlockStorer.
+type MockRouteBlockStorerMockRecorder struct {
+	mock *MockRouteBlockStorer
+}
+
+// NewMockRouteBlockStorer creates a new mock instance.
+func NewMockRouteBlockStorer(ctrl *gomock.Controller) *MockRouteBlockStorer {
+	mock := &MockRouteBlockStorer{ctrl: ctrl}
+	mock.recorder = &MockRouteBlockStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRouteBlockStorer) EXPECT() *MockRouteBlockStorerMockRecorder {
+	return m.recorder
+}
+
+// LatestBlock mocks base method.
+func (m *MockRouteBlockStorer) LatestBlock(sourceDomainID, destinationDomainID uint8) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock", sourceDomainID, destinationDomainID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockRouteBlockStorerMockRecorder) LatestBlock(sourceDomainID, destinationDomainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockRouteBlockStorer)(nil).LatestBlock), sourceDomainID, destinationDomainID)
+}
+
+// StoreBlock mocks base method.
+func (m *MockRouteBlockStorer) StoreBlock(sourceDomainID, destinationDomainID uint8, blockNumber *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreBlock", sourceDomainID, destinationDomainID, blockNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreBlock indicates an expected call of StoreBlock.
+func (mr *MockRouteBlockStorerMockRecorder) StoreBlock(sourceDomainID, destinationDomainID, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreBlock", reflect.TypeOf((*MockRouteBlockStorer)(nil).StoreBlock), sourceDomainID, destinationDomainID, blockNumber)
+}
+
+// MockRetryEntryFetcher is a mock of RetryEntryFetcher interface.
+type MockRetryEntryFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetryEntryFetcherMockRecorder
+}
+
+// MockRetryEntryFetcherMockRecorder is the mock recorder for MockRetryEntryFetcher.
+type MockRetryEntryFetcherMockRecorder struct {
+	mock *MockRetryEntryFetcher
+}
+
+// NewMockRetryEntryFetcher creates a new mock instance.
+func NewMockRetryEntryFetcher(ctrl *gomock.Controller) *MockRetryEntryFetcher {
+	mock := &MockRetryEntryFetcher{ctrl: ctrl}
+	mock.recorder = &MockRetryEntryFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetryEntryFetcher) EXPECT() *MockRetryEntryFetcherMockRecorder {
+	return m.recorder
+}
+
+// DeadLetters mocks base method.
+func (m *MockRetryEntryFetcher) DeadLetters(domainID uint8) ([]*store.RetryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetters", domainID)
+	ret0, _ := ret[0].([]*store.RetryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetters indicates an expected call of DeadLetters.
+func (mr *MockRetryEntryFetcherMockRecorder) DeadLetters(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetters", reflect.TypeOf((*MockRetryEntryFetcher)(nil).DeadLetters), domainID)
+}
+
+// RetryQueue mocks base method.
+func (m *MockRetryEntryFetcher) RetryQueue(domainID uint8) ([]*store.RetryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryQueue", domainID)
+	ret0, _ := ret[0].([]*store.RetryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryQueue indicates an expected call of RetryQueue.
+func (mr *MockRetryEntryFetcherMockRecorder) RetryQueue(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryQueue", reflect.TypeOf((*MockRetryEntryFetcher)(nil).RetryQueue), domainID)
+}
+
+// MockExecutionFetcher is a mock of ExecutionFetcher interface.
+type MockExecutionFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionFetcherMockRecorder
+}
+
+// MockExecutionFetcherMockRecorder is the mock recorder for MockExecutionFetcher.
+type MockExecutionFetcherMockRecorder struct {
+	mock *MockExecutionFetcher
+}
+
+// NewMockExecutionFetcher creates a new mock instance.
+func NewMockExecutionFetcher(ctrl *gomock.Controller) *MockExecutionFetcher {
+	mock := &MockExecutionFetcher{ctrl: ctrl}
+	mock.recorder = &MockExecutionFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutionFetcher) EXPECT() *MockExecutionFetcherMockRecorder {
+	return m.recorder
+}
+
+// Execution mocks base method.
+func (m *MockExecutionFetcher) Execution(messageID string) (*store.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execution", messageID)
+	ret0, _ := ret[0].(*store.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockExecutionFetcherMockRecorder) Execution(messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockExecutionFetcher)(nil).Execution), messageID)
+}
+
+// PendingTransactions mocks base method.
+func (m *MockExecutionFetcher) PendingTransactions(domainID uint8) ([]*store.PendingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTransactions", domainID)
+	ret0, _ := ret[0].([]*store.PendingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingTransactions indicates an expected call of PendingTransactions.
+func (mr *MockExecutionFetcherMockRecorder) PendingTransactions(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTransactions", reflect.TypeOf((*MockExecutionFetcher)(nil).PendingTransactions), domainID)
+}
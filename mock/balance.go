@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/balance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/balance.go -destination=./mock/balance.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBalanceClient is a mock of BalanceClient interface.
+type MockBalanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceClientMockRecorder
+}
+
+// MockBalanceClientMockRecorder is the mock recorder for MockBalanceClient.
+type MockBalanceClientMockRecorder struct {
+	mock *MockBalanceClient
+}
+
+// NewMockBalanceClient creates a new mock instance.
+func NewMockBalanceClient(ctrl *gomock.Controller) *MockBalanceClient {
+	mock := &MockBalanceClient{ctrl: ctrl}
+	mock.recorder = &MockBalanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceClient) EXPECT() *MockBalanceClientMockRecorder {
+	return m.recorder
+}
+
+// BalanceAt mocks base method.
+func (m *MockBalanceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockBalanceClientMockRecorder) BalanceAt(ctx, account, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockBalanceClient)(nil).BalanceAt), ctx, account, blockNumber)
+}
+
+// From mocks base method.
+func (m *MockBalanceClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockBalanceClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockBalanceClient)(nil).From))
+}
+
+// MockBalanceMetrics is a mock of BalanceMetrics interface.
+type MockBalanceMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceMetricsMockRecorder
+}
+
+// MockBalanceMetricsMockRecorder is the mock recorder for MockBalanceMetrics.
+type MockBalanceMetricsMockRecorder struct {
+	mock *MockBalanceMetrics
+}
+
+// NewMockBalanceMetrics creates a new mock instance.
+func NewMockBalanceMetrics(ctrl *gomock.Controller) *MockBalanceMetrics {
+	mock := &MockBalanceMetrics{ctrl: ctrl}
+	mock.recorder = &MockBalanceMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceMetrics) EXPECT() *MockBalanceMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackExecutionPaused mocks base method.
+func (m *MockBalanceMetrics) TrackExecutionPaused(domainID uint8, paused bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackExecutionPaused", domainID, paused)
+}
+
+// TrackExecutionPaused indicates an expected call of TrackExecutionPaused.
+func (mr *MockBalanceMetricsMockRecorder) TrackExecutionPaused(domainID, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackExecutionPaused", reflect.TypeOf((*MockBalanceMetrics)(nil).TrackExecutionPaused), domainID, paused)
+}
+
+// TrackSignerBalance mocks base method.
+func (m *MockBalanceMetrics) TrackSignerBalance(domainID uint8, balance *big.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackSignerBalance", domainID, balance)
+}
+
+// TrackSignerBalance indicates an expected call of TrackSignerBalance.
+func (mr *MockBalanceMetricsMockRecorder) TrackSignerBalance(domainID, balance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackSignerBalance", reflect.TypeOf((*MockBalanceMetrics)(nil).TrackSignerBalance), domainID, balance)
+}
@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./health/checkers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./health/checkers.go -destination=./mock/checkers.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	api "github.com/attestantio/go-eth2-client/api"
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHeaderFetcher is a mock of HeaderFetcher interface.
+type MockHeaderFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeaderFetcherMockRecorder
+}
+
+// MockHeaderFetcherMockRecorder is the mock recorder for MockHeaderFetcher.
+type MockHeaderFetcherMockRecorder struct {
+	mock *MockHeaderFetcher
+}
+
+// NewMockHeaderFetcher creates a new mock instance.
+func NewMockHeaderFetcher(ctrl *gomock.Controller) *MockHeaderFetcher {
+	mock := &MockHeaderFetcher{ctrl: ctrl}
+	mock.recorder = &MockHeaderFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeaderFetcher) EXPECT() *MockHeaderFetcherMockRecorder {
+	return m.recorder
+}
+
+// HeaderByNumber mocks base method.
+func (m *MockHeaderFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number)
+	ret0, _ := ret[0].(*types.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockHeaderFetcherMockRecorder) HeaderByNumber(ctx, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockHeaderFetcher)(nil).HeaderByNumber), ctx, number)
+}
+
+// MockSyncStatusFetcher is a mock of SyncStatusFetcher interface.
+type MockSyncStatusFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncStatusFetcherMockRecorder
+}
+
+// MockSyncStatusFetcherMockRecorder is the mock recorder for MockSyncStatusFetcher.
+type MockSyncStatusFetcherMockRecorder struct {
+	mock *MockSyncStatusFetcher
+}
+
+// NewMockSyncStatusFetcher creates a new mock instance.
+func NewMockSyncStatusFetcher(ctrl *gomock.Controller) *MockSyncStatusFetcher {
+	mock := &MockSyncStatusFetcher{ctrl: ctrl}
+	mock.recorder = &MockSyncStatusFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncStatusFetcher) EXPECT() *MockSyncStatusFetcherMockRecorder {
+	return m.recorder
+}
+
+// NodeSyncing mocks base method.
+func (m *MockSyncStatusFetcher) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*api.Response[*v1.SyncState], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeSyncing", ctx, opts)
+	ret0, _ := ret[0].(*api.Response[*v1.SyncState])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeSyncing indicates an expected call of NodeSyncing.
+func (mr *MockSyncStatusFetcherMockRecorder) NodeSyncing(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSyncing", reflect.TypeOf((*MockSyncStatusFetcher)(nil).NodeSyncing), ctx, opts)
+}
+
+// MockVersionFetcher is a mock of VersionFetcher interface.
+type MockVersionFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionFetcherMockRecorder
+}
+
+// MockVersionFetcherMockRecorder is the mock recorder for MockVersionFetcher.
+type MockVersionFetcherMockRecorder struct {
+	mock *MockVersionFetcher
+}
+
+// NewMockVersionFetcher creates a new mock instance.
+func NewMockVersionFetcher(ctrl *gomock.Controller) *MockVersionFetcher {
+	mock := &MockVersionFetcher{ctrl: ctrl}
+	mock.recorder = &MockVersionFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionFetcher) EXPECT() *MockVersionFetcherMockRecorder {
+	return m.recorder
+}
+
+// NodeVersion mocks base method.
+func (m *MockVersionFetcher) NodeVersion(ctx context.Context, opts *api.NodeVersionOpts) (*api.Response[string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeVersion", ctx, opts)
+	ret0, _ := ret[0].(*api.Response[string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeVersion indicates an expected call of NodeVersion.
+func (mr *MockVersionFetcherMockRecorder) NodeVersion(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeVersion", reflect.TypeOf((*MockVersionFetcher)(nil).NodeVersion), ctx, opts)
+}
@@ -12,6 +12,7 @@ import (
 	context "context"
 	big "math/big"
 	reflect "reflect"
+	time "time"
 
 	common "github.com/ethereum/go-ethereum/common"
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -104,3 +105,50 @@ func (mr *MockClientMockRecorder) TransactionReceipt(ctx, txHash any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockClient)(nil).TransactionReceipt), ctx, txHash)
 }
+
+// MockDepositMetrics is a mock of DepositMetrics interface.
+type MockDepositMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositMetricsMockRecorder
+}
+
+// MockDepositMetricsMockRecorder is the mock recorder for MockDepositMetrics.
+type MockDepositMetricsMockRecorder struct {
+	mock *MockDepositMetrics
+}
+
+// NewMockDepositMetrics creates a new mock instance.
+func NewMockDepositMetrics(ctrl *gomock.Controller) *MockDepositMetrics {
+	mock := &MockDepositMetrics{ctrl: ctrl}
+	mock.recorder = &MockDepositMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositMetrics) EXPECT() *MockDepositMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackDepositsFound mocks base method.
+func (m *MockDepositMetrics) TrackDepositsFound(domainID, destinationDomainID uint8, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackDepositsFound", domainID, destinationDomainID, count)
+}
+
+// TrackDepositsFound indicates an expected call of TrackDepositsFound.
+func (mr *MockDepositMetricsMockRecorder) TrackDepositsFound(domainID, destinationDomainID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackDepositsFound", reflect.TypeOf((*MockDepositMetrics)(nil).TrackDepositsFound), domainID, destinationDomainID, count)
+}
+
+// TrackProofLatency mocks base method.
+func (m *MockDepositMetrics) TrackProofLatency(proofType string, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackProofLatency", proofType, duration)
+}
+
+// TrackProofLatency indicates an expected call of TrackProofLatency.
+func (mr *MockDepositMetricsMockRecorder) TrackProofLatency(proofType, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackProofLatency", reflect.TypeOf((*MockDepositMetrics)(nil).TrackProofLatency), proofType, duration)
+}
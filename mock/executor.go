@@ -0,0 +1,474 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/executor.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/executor.go -destination=./mock/executor.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	transactor "github.com/sygmaprotocol/sygma-core/chains/evm/transactor"
+	proposal "github.com/sygmaprotocol/sygma-core/relayer/proposal"
+	contracts "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/contracts"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExecutorContract is a mock of ExecutorContract interface.
+type MockExecutorContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutorContractMockRecorder
+}
+
+// MockExecutorContractMockRecorder is the mock recorder for MockExecutorContract.
+type MockExecutorContractMockRecorder struct {
+	mock *MockExecutorContract
+}
+
+// NewMockExecutorContract creates a new mock instance.
+func NewMockExecutorContract(ctrl *gomock.Controller) *MockExecutorContract {
+	mock := &MockExecutorContract{ctrl: ctrl}
+	mock.recorder = &MockExecutorContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutorContract) EXPECT() *MockExecutorContractMockRecorder {
+	return m.recorder
+}
+
+// ContractAddress mocks base method.
+func (m *MockExecutorContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockExecutorContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockExecutorContract)(nil).ContractAddress))
+}
+
+// ExecuteProposals mocks base method.
+func (m *MockExecutorContract) ExecuteProposals(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteProposals", proposals, accountProof, slot, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteProposals indicates an expected call of ExecuteProposals.
+func (mr *MockExecutorContractMockRecorder) ExecuteProposals(proposals, accountProof, slot, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteProposals", reflect.TypeOf((*MockExecutorContract)(nil).ExecuteProposals), proposals, accountProof, slot, opts)
+}
+
+// ExecuteProposalsCalldata mocks base method.
+func (m *MockExecutorContract) ExecuteProposalsCalldata(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteProposalsCalldata", proposals, accountProof, slot)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteProposalsCalldata indicates an expected call of ExecuteProposalsCalldata.
+func (mr *MockExecutorContractMockRecorder) ExecuteProposalsCalldata(proposals, accountProof, slot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteProposalsCalldata", reflect.TypeOf((*MockExecutorContract)(nil).ExecuteProposalsCalldata), proposals, accountProof, slot)
+}
+
+// IsProposalExecuted mocks base method.
+func (m *MockExecutorContract) IsProposalExecuted(p *proposal.Proposal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProposalExecuted", p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProposalExecuted indicates an expected call of IsProposalExecuted.
+func (mr *MockExecutorContractMockRecorder) IsProposalExecuted(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProposalExecuted", reflect.TypeOf((*MockExecutorContract)(nil).IsProposalExecuted), p)
+}
+
+// SimulateExecuteProposals mocks base method.
+func (m *MockExecutorContract) SimulateExecuteProposals(proposals []contracts.ExecutorProposal, accountProof [][]byte, slot *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateExecuteProposals", proposals, accountProof, slot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SimulateExecuteProposals indicates an expected call of SimulateExecuteProposals.
+func (mr *MockExecutorContractMockRecorder) SimulateExecuteProposals(proposals, accountProof, slot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecuteProposals", reflect.TypeOf((*MockExecutorContract)(nil).SimulateExecuteProposals), proposals, accountProof, slot)
+}
+
+// MockHashiContract is a mock of HashiContract interface.
+type MockHashiContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockHashiContractMockRecorder
+}
+
+// MockHashiContractMockRecorder is the mock recorder for MockHashiContract.
+type MockHashiContractMockRecorder struct {
+	mock *MockHashiContract
+}
+
+// NewMockHashiContract creates a new mock instance.
+func NewMockHashiContract(ctrl *gomock.Controller) *MockHashiContract {
+	mock := &MockHashiContract{ctrl: ctrl}
+	mock.recorder = &MockHashiContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHashiContract) EXPECT() *MockHashiContractMockRecorder {
+	return m.recorder
+}
+
+// ContractAddress mocks base method.
+func (m *MockHashiContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockHashiContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockHashiContract)(nil).ContractAddress))
+}
+
+// MessageHash mocks base method.
+func (m *MockHashiContract) MessageHash(messageID *big.Int) ([32]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageHash", messageID)
+	ret0, _ := ret[0].([32]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MessageHash indicates an expected call of MessageHash.
+func (mr *MockHashiContractMockRecorder) MessageHash(messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageHash", reflect.TypeOf((*MockHashiContract)(nil).MessageHash), messageID)
+}
+
+// VerifyAndStoreDispatchedMessage mocks base method.
+func (m *MockHashiContract) VerifyAndStoreDispatchedMessage(srcSlot, txSlot uint64, receiptsRootProof [][]byte, receiptsRoot [32]byte, receiptProof [][]byte, txIndexRLPEncoded []byte, logIndex *big.Int, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAndStoreDispatchedMessage", srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAndStoreDispatchedMessage indicates an expected call of VerifyAndStoreDispatchedMessage.
+func (mr *MockHashiContractMockRecorder) VerifyAndStoreDispatchedMessage(srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAndStoreDispatchedMessage", reflect.TypeOf((*MockHashiContract)(nil).VerifyAndStoreDispatchedMessage), srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex, opts)
+}
+
+// VerifyAndStoreDispatchedMessageCalldata mocks base method.
+func (m *MockHashiContract) VerifyAndStoreDispatchedMessageCalldata(srcSlot, txSlot uint64, receiptsRootProof [][]byte, receiptsRoot [32]byte, receiptProof [][]byte, txIndexRLPEncoded []byte, logIndex *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAndStoreDispatchedMessageCalldata", srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAndStoreDispatchedMessageCalldata indicates an expected call of VerifyAndStoreDispatchedMessageCalldata.
+func (mr *MockHashiContractMockRecorder) VerifyAndStoreDispatchedMessageCalldata(srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAndStoreDispatchedMessageCalldata", reflect.TypeOf((*MockHashiContract)(nil).VerifyAndStoreDispatchedMessageCalldata), srcSlot, txSlot, receiptsRootProof, receiptsRoot, receiptProof, txIndexRLPEncoded, logIndex)
+}
+
+// MockMulticallContract is a mock of MulticallContract interface.
+type MockMulticallContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticallContractMockRecorder
+}
+
+// MockMulticallContractMockRecorder is the mock recorder for MockMulticallContract.
+type MockMulticallContractMockRecorder struct {
+	mock *MockMulticallContract
+}
+
+// NewMockMulticallContract creates a new mock instance.
+func NewMockMulticallContract(ctrl *gomock.Controller) *MockMulticallContract {
+	mock := &MockMulticallContract{ctrl: ctrl}
+	mock.recorder = &MockMulticallContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticallContract) EXPECT() *MockMulticallContractMockRecorder {
+	return m.recorder
+}
+
+// Aggregate3 mocks base method.
+func (m *MockMulticallContract) Aggregate3(calls []contracts.Call3, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate3", calls, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate3 indicates an expected call of Aggregate3.
+func (mr *MockMulticallContractMockRecorder) Aggregate3(calls, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate3", reflect.TypeOf((*MockMulticallContract)(nil).Aggregate3), calls, opts)
+}
+
+// Aggregate3Calldata mocks base method.
+func (m *MockMulticallContract) Aggregate3Calldata(calls []contracts.Call3) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate3Calldata", calls)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate3Calldata indicates an expected call of Aggregate3Calldata.
+func (mr *MockMulticallContractMockRecorder) Aggregate3Calldata(calls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate3Calldata", reflect.TypeOf((*MockMulticallContract)(nil).Aggregate3Calldata), calls)
+}
+
+// ContractAddress mocks base method.
+func (m *MockMulticallContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockMulticallContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockMulticallContract)(nil).ContractAddress))
+}
+
+// SimulateAggregate3 mocks base method.
+func (m *MockMulticallContract) SimulateAggregate3(calls []contracts.Call3) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateAggregate3", calls)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SimulateAggregate3 indicates an expected call of SimulateAggregate3.
+func (mr *MockMulticallContractMockRecorder) SimulateAggregate3(calls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateAggregate3", reflect.TypeOf((*MockMulticallContract)(nil).SimulateAggregate3), calls)
+}
+
+// MockGasEstimator is a mock of GasEstimator interface.
+type MockGasEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasEstimatorMockRecorder
+}
+
+// MockGasEstimatorMockRecorder is the mock recorder for MockGasEstimator.
+type MockGasEstimatorMockRecorder struct {
+	mock *MockGasEstimator
+}
+
+// NewMockGasEstimator creates a new mock instance.
+func NewMockGasEstimator(ctrl *gomock.Controller) *MockGasEstimator {
+	mock := &MockGasEstimator{ctrl: ctrl}
+	mock.recorder = &MockGasEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasEstimator) EXPECT() *MockGasEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateGas mocks base method.
+func (m *MockGasEstimator) EstimateGas(to *common.Address, calldata []byte, fallbackGas uint64) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", to, calldata, fallbackGas)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockGasEstimatorMockRecorder) EstimateGas(to, calldata, fallbackGas any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockGasEstimator)(nil).EstimateGas), to, calldata, fallbackGas)
+}
+
+// MockExecutionWatcher is a mock of ExecutionWatcher interface.
+type MockExecutionWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionWatcherMockRecorder
+}
+
+// MockExecutionWatcherMockRecorder is the mock recorder for MockExecutionWatcher.
+type MockExecutionWatcherMockRecorder struct {
+	mock *MockExecutionWatcher
+}
+
+// NewMockExecutionWatcher creates a new mock instance.
+func NewMockExecutionWatcher(ctrl *gomock.Controller) *MockExecutionWatcher {
+	mock := &MockExecutionWatcher{ctrl: ctrl}
+	mock.recorder = &MockExecutionWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutionWatcher) EXPECT() *MockExecutionWatcherMockRecorder {
+	return m.recorder
+}
+
+// Resume mocks base method.
+func (m *MockExecutionWatcher) Resume(onFailure func([]*proposal.Proposal, uint64, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", onFailure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockExecutionWatcherMockRecorder) Resume(onFailure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockExecutionWatcher)(nil).Resume), onFailure)
+}
+
+// Watch mocks base method.
+func (m *MockExecutionWatcher) Watch(props []*proposal.Proposal, txHash common.Hash, attempts uint64, onFailure func([]*proposal.Proposal, uint64, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Watch", props, txHash, attempts, onFailure)
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockExecutionWatcherMockRecorder) Watch(props, txHash, attempts, onFailure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockExecutionWatcher)(nil).Watch), props, txHash, attempts, onFailure)
+}
+
+// MockRetryQueue is a mock of RetryQueue interface.
+type MockRetryQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetryQueueMockRecorder
+}
+
+// MockRetryQueueMockRecorder is the mock recorder for MockRetryQueue.
+type MockRetryQueueMockRecorder struct {
+	mock *MockRetryQueue
+}
+
+// NewMockRetryQueue creates a new mock instance.
+func NewMockRetryQueue(ctrl *gomock.Controller) *MockRetryQueue {
+	mock := &MockRetryQueue{ctrl: ctrl}
+	mock.recorder = &MockRetryQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetryQueue) EXPECT() *MockRetryQueueMockRecorder {
+	return m.recorder
+}
+
+// Push mocks base method.
+func (m *MockRetryQueue) Push(props []*proposal.Proposal, attempts uint64, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", props, attempts, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockRetryQueueMockRecorder) Push(props, attempts, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockRetryQueue)(nil).Push), props, attempts, err)
+}
+
+// MockBalanceMonitor is a mock of BalanceMonitor interface.
+type MockBalanceMonitor struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceMonitorMockRecorder
+}
+
+// MockBalanceMonitorMockRecorder is the mock recorder for MockBalanceMonitor.
+type MockBalanceMonitorMockRecorder struct {
+	mock *MockBalanceMonitor
+}
+
+// NewMockBalanceMonitor creates a new mock instance.
+func NewMockBalanceMonitor(ctrl *gomock.Controller) *MockBalanceMonitor {
+	mock := &MockBalanceMonitor{ctrl: ctrl}
+	mock.recorder = &MockBalanceMonitorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceMonitor) EXPECT() *MockBalanceMonitorMockRecorder {
+	return m.recorder
+}
+
+// Paused mocks base method.
+func (m *MockBalanceMonitor) Paused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Paused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Paused indicates an expected call of Paused.
+func (mr *MockBalanceMonitorMockRecorder) Paused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paused", reflect.TypeOf((*MockBalanceMonitor)(nil).Paused))
+}
+
+// MockExecutorMetrics is a mock of ExecutorMetrics interface.
+type MockExecutorMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutorMetricsMockRecorder
+}
+
+// MockExecutorMetricsMockRecorder is the mock recorder for MockExecutorMetrics.
+type MockExecutorMetricsMockRecorder struct {
+	mock *MockExecutorMetrics
+}
+
+// NewMockExecutorMetrics creates a new mock instance.
+func NewMockExecutorMetrics(ctrl *gomock.Controller) *MockExecutorMetrics {
+	mock := &MockExecutorMetrics{ctrl: ctrl}
+	mock.recorder = &MockExecutorMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutorMetrics) EXPECT() *MockExecutorMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackExecutionsSubmitted mocks base method.
+func (m *MockExecutorMetrics) TrackExecutionsSubmitted(domainID uint8, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackExecutionsSubmitted", domainID, count)
+}
+
+// TrackExecutionsSubmitted indicates an expected call of TrackExecutionsSubmitted.
+func (mr *MockExecutorMetricsMockRecorder) TrackExecutionsSubmitted(domainID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackExecutionsSubmitted", reflect.TypeOf((*MockExecutorMetrics)(nil).TrackExecutionsSubmitted), domainID, count)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/gas.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/gas.go -destination=./mock/gas.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGasEstimatorClient is a mock of GasEstimatorClient interface.
+type MockGasEstimatorClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasEstimatorClientMockRecorder
+}
+
+// MockGasEstimatorClientMockRecorder is the mock recorder for MockGasEstimatorClient.
+type MockGasEstimatorClientMockRecorder struct {
+	mock *MockGasEstimatorClient
+}
+
+// NewMockGasEstimatorClient creates a new mock instance.
+func NewMockGasEstimatorClient(ctrl *gomock.Controller) *MockGasEstimatorClient {
+	mock := &MockGasEstimatorClient{ctrl: ctrl}
+	mock.recorder = &MockGasEstimatorClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasEstimatorClient) EXPECT() *MockGasEstimatorClientMockRecorder {
+	return m.recorder
+}
+
+// EstimateGas mocks base method.
+func (m *MockGasEstimatorClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockGasEstimatorClientMockRecorder) EstimateGas(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockGasEstimatorClient)(nil).EstimateGas), ctx, msg)
+}
+
+// From mocks base method.
+func (m *MockGasEstimatorClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockGasEstimatorClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockGasEstimatorClient)(nil).From))
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers (interfaces: ReceiptProver,RootProver)
+// Source: github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers (interfaces: ReceiptProver,RootProver,HashiMetrics)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mock/hashi.go -package mock github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers ReceiptProver,RootProver
+//	mockgen -destination=./mock/hashi.go -package mock github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/listener/handlers ReceiptProver,RootProver,HashiMetrics
 //
 // Package mock is a generated GoMock package.
 package mock
@@ -12,8 +12,10 @@ import (
 	context "context"
 	big "math/big"
 	reflect "reflect"
+	time "time"
 
 	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +57,21 @@ func (mr *MockReceiptProverMockRecorder) ReceiptProof(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiptProof", reflect.TypeOf((*MockReceiptProver)(nil).ReceiptProof), arg0)
 }
 
+// VerifyReceiptProof mocks base method.
+func (m *MockReceiptProver) VerifyReceiptProof(arg0 common.Hash, arg1 []byte, arg2 [][]byte, arg3 *big.Int) (*types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyReceiptProof", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyReceiptProof indicates an expected call of VerifyReceiptProof.
+func (mr *MockReceiptProverMockRecorder) VerifyReceiptProof(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyReceiptProof", reflect.TypeOf((*MockReceiptProver)(nil).VerifyReceiptProof), arg0, arg1, arg2, arg3)
+}
+
 // MockRootProver is a mock of RootProver interface.
 type MockRootProver struct {
 	ctrl     *gomock.Controller
@@ -92,3 +109,64 @@ func (mr *MockRootProverMockRecorder) ReceiptsRootProof(arg0, arg1, arg2 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiptsRootProof", reflect.TypeOf((*MockRootProver)(nil).ReceiptsRootProof), arg0, arg1, arg2)
 }
+
+// VerifyReceiptsRootProof mocks base method.
+func (m *MockRootProver) VerifyReceiptsRootProof(arg0 context.Context, arg1, arg2 *big.Int, arg3 [32]byte, arg4 [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyReceiptsRootProof", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyReceiptsRootProof indicates an expected call of VerifyReceiptsRootProof.
+func (mr *MockRootProverMockRecorder) VerifyReceiptsRootProof(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyReceiptsRootProof", reflect.TypeOf((*MockRootProver)(nil).VerifyReceiptsRootProof), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockHashiMetrics is a mock of HashiMetrics interface.
+type MockHashiMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockHashiMetricsMockRecorder
+}
+
+// MockHashiMetricsMockRecorder is the mock recorder for MockHashiMetrics.
+type MockHashiMetricsMockRecorder struct {
+	mock *MockHashiMetrics
+}
+
+// NewMockHashiMetrics creates a new mock instance.
+func NewMockHashiMetrics(ctrl *gomock.Controller) *MockHashiMetrics {
+	mock := &MockHashiMetrics{ctrl: ctrl}
+	mock.recorder = &MockHashiMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHashiMetrics) EXPECT() *MockHashiMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackHashiMessagesFound mocks base method.
+func (m *MockHashiMetrics) TrackHashiMessagesFound(arg0, arg1 byte, arg2 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackHashiMessagesFound", arg0, arg1, arg2)
+}
+
+// TrackHashiMessagesFound indicates an expected call of TrackHashiMessagesFound.
+func (mr *MockHashiMetricsMockRecorder) TrackHashiMessagesFound(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackHashiMessagesFound", reflect.TypeOf((*MockHashiMetrics)(nil).TrackHashiMessagesFound), arg0, arg1, arg2)
+}
+
+// TrackProofLatency mocks base method.
+func (m *MockHashiMetrics) TrackProofLatency(arg0 string, arg1 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackProofLatency", arg0, arg1)
+}
+
+// TrackProofLatency indicates an expected call of TrackProofLatency.
+func (mr *MockHashiMetricsMockRecorder) TrackProofLatency(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackProofLatency", reflect.TypeOf((*MockHashiMetrics)(nil).TrackProofLatency), arg0, arg1)
+}
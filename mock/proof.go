@@ -55,6 +55,40 @@ func (mr *MockTransactionFetcherMockRecorder) BlockByHash(ctx, hash any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByHash", reflect.TypeOf((*MockTransactionFetcher)(nil).BlockByHash), ctx, hash)
 }
 
+// CallContext mocks base method.
+func (m *MockTransactionFetcher) CallContext(ctx context.Context, target any, rpcMethod string, args ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, target, rpcMethod}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CallContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallContext indicates an expected call of CallContext.
+func (mr *MockTransactionFetcherMockRecorder) CallContext(ctx, target, rpcMethod any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, target, rpcMethod}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContext", reflect.TypeOf((*MockTransactionFetcher)(nil).CallContext), varargs...)
+}
+
+// HeaderByHash mocks base method.
+func (m *MockTransactionFetcher) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByHash", ctx, hash)
+	ret0, _ := ret[0].(*types.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByHash indicates an expected call of HeaderByHash.
+func (mr *MockTransactionFetcherMockRecorder) HeaderByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByHash", reflect.TypeOf((*MockTransactionFetcher)(nil).HeaderByHash), ctx, hash)
+}
+
 // TransactionReceipt mocks base method.
 func (m *MockTransactionFetcher) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +103,50 @@ func (mr *MockTransactionFetcherMockRecorder) TransactionReceipt(ctx, txHash any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockTransactionFetcher)(nil).TransactionReceipt), ctx, txHash)
 }
+
+// MockReceiptProverMetrics is a mock of ReceiptProverMetrics interface.
+type MockReceiptProverMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptProverMetricsMockRecorder
+}
+
+// MockReceiptProverMetricsMockRecorder is the mock recorder for MockReceiptProverMetrics.
+type MockReceiptProverMetricsMockRecorder struct {
+	mock *MockReceiptProverMetrics
+}
+
+// NewMockReceiptProverMetrics creates a new mock instance.
+func NewMockReceiptProverMetrics(ctrl *gomock.Controller) *MockReceiptProverMetrics {
+	mock := &MockReceiptProverMetrics{ctrl: ctrl}
+	mock.recorder = &MockReceiptProverMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptProverMetrics) EXPECT() *MockReceiptProverMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackReceiptTrieCacheHit mocks base method.
+func (m *MockReceiptProverMetrics) TrackReceiptTrieCacheHit() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackReceiptTrieCacheHit")
+}
+
+// TrackReceiptTrieCacheHit indicates an expected call of TrackReceiptTrieCacheHit.
+func (mr *MockReceiptProverMetricsMockRecorder) TrackReceiptTrieCacheHit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackReceiptTrieCacheHit", reflect.TypeOf((*MockReceiptProverMetrics)(nil).TrackReceiptTrieCacheHit))
+}
+
+// TrackReceiptTrieCacheMiss mocks base method.
+func (m *MockReceiptProverMetrics) TrackReceiptTrieCacheMiss() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackReceiptTrieCacheMiss")
+}
+
+// TrackReceiptTrieCacheMiss indicates an expected call of TrackReceiptTrieCacheMiss.
+func (mr *MockReceiptProverMetricsMockRecorder) TrackReceiptTrieCacheMiss() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackReceiptTrieCacheMiss", reflect.TypeOf((*MockReceiptProverMetrics)(nil).TrackReceiptTrieCacheMiss))
+}
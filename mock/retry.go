@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/retry.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/retry.go -destination=./mock/retry.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	store "github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetryStorer is a mock of RetryStorer interface.
+type MockRetryStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetryStorerMockRecorder
+}
+
+// MockRetryStorerMockRecorder is the mock recorder for MockRetryStorer.
+type MockRetryStorerMockRecorder struct {
+	mock *MockRetryStorer
+}
+
+// NewMockRetryStorer creates a new mock instance.
+func NewMockRetryStorer(ctrl *gomock.Controller) *MockRetryStorer {
+	mock := &MockRetryStorer{ctrl: ctrl}
+	mock.recorder = &MockRetryStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetryStorer) EXPECT() *MockRetryStorerMockRecorder {
+	return m.recorder
+}
+
+// DeadLetters mocks base method.
+func (m *MockRetryStorer) DeadLetters(domainID uint8) ([]*store.RetryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetters", domainID)
+	ret0, _ := ret[0].([]*store.RetryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetters indicates an expected call of DeadLetters.
+func (mr *MockRetryStorerMockRecorder) DeadLetters(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetters", reflect.TypeOf((*MockRetryStorer)(nil).DeadLetters), domainID)
+}
+
+// RetryQueue mocks base method.
+func (m *MockRetryStorer) RetryQueue(domainID uint8) ([]*store.RetryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryQueue", domainID)
+	ret0, _ := ret[0].([]*store.RetryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryQueue indicates an expected call of RetryQueue.
+func (mr *MockRetryStorerMockRecorder) RetryQueue(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryQueue", reflect.TypeOf((*MockRetryStorer)(nil).RetryQueue), domainID)
+}
+
+// StoreDeadLetters mocks base method.
+func (m *MockRetryStorer) StoreDeadLetters(domainID uint8, entries []*store.RetryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreDeadLetters", domainID, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreDeadLetters indicates an expected call of StoreDeadLetters.
+func (mr *MockRetryStorerMockRecorder) StoreDeadLetters(domainID, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreDeadLetters", reflect.TypeOf((*MockRetryStorer)(nil).StoreDeadLetters), domainID, entries)
+}
+
+// StoreRetryQueue mocks base method.
+func (m *MockRetryStorer) StoreRetryQueue(domainID uint8, entries []*store.RetryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRetryQueue", domainID, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRetryQueue indicates an expected call of StoreRetryQueue.
+func (mr *MockRetryStorerMockRecorder) StoreRetryQueue(domainID, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRetryQueue", reflect.TypeOf((*MockRetryStorer)(nil).StoreRetryQueue), domainID, entries)
+}
@@ -10,11 +10,12 @@ package mock
 
 import (
 	context "context"
+	big "math/big"
 	reflect "reflect"
 
 	api "github.com/attestantio/go-eth2-client/api"
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
-	spec "github.com/attestantio/go-eth2-client/spec"
+	beacon "github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,19 +42,85 @@ func (m *MockBeaconStateFetcher) EXPECT() *MockBeaconStateFetcherMockRecorder {
 	return m.recorder
 }
 
-// BeaconState mocks base method.
-func (m *MockBeaconStateFetcher) BeaconState(ctx context.Context, opts *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error) {
+// BeaconStateSSZ mocks base method.
+func (m *MockBeaconStateFetcher) BeaconStateSSZ(ctx context.Context, stateID string) (*beacon.SSZResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BeaconState", ctx, opts)
-	ret0, _ := ret[0].(*api.Response[*spec.VersionedBeaconState])
+	ret := m.ctrl.Call(m, "BeaconStateSSZ", ctx, stateID)
+	ret0, _ := ret[0].(*beacon.SSZResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BeaconState indicates an expected call of BeaconState.
-func (mr *MockBeaconStateFetcherMockRecorder) BeaconState(ctx, opts any) *gomock.Call {
+// BeaconStateSSZ indicates an expected call of BeaconStateSSZ.
+func (mr *MockBeaconStateFetcherMockRecorder) BeaconStateSSZ(ctx, stateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconState", reflect.TypeOf((*MockBeaconStateFetcher)(nil).BeaconState), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconStateSSZ", reflect.TypeOf((*MockBeaconStateFetcher)(nil).BeaconStateSSZ), ctx, stateID)
+}
+
+// MockBeaconStateStore is a mock of BeaconStateStore interface.
+type MockBeaconStateStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconStateStoreMockRecorder
+}
+
+// MockBeaconStateStoreMockRecorder is the mock recorder for MockBeaconStateStore.
+type MockBeaconStateStoreMockRecorder struct {
+	mock *MockBeaconStateStore
+}
+
+// NewMockBeaconStateStore creates a new mock instance.
+func NewMockBeaconStateStore(ctrl *gomock.Controller) *MockBeaconStateStore {
+	mock := &MockBeaconStateStore{ctrl: ctrl}
+	mock.recorder = &MockBeaconStateStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconStateStore) EXPECT() *MockBeaconStateStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteState mocks base method.
+func (m *MockBeaconStateStore) DeleteState(slot *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", slot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBeaconStateStoreMockRecorder) DeleteState(slot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBeaconStateStore)(nil).DeleteState), slot)
+}
+
+// State mocks base method.
+func (m *MockBeaconStateStore) State(slot *big.Int) (*beacon.SSZResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "State", slot)
+	ret0, _ := ret[0].(*beacon.SSZResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// State indicates an expected call of State.
+func (mr *MockBeaconStateStoreMockRecorder) State(slot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockBeaconStateStore)(nil).State), slot)
+}
+
+// StoreState mocks base method.
+func (m *MockBeaconStateStore) StoreState(slot *big.Int, state *beacon.SSZResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreState", slot, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreState indicates an expected call of StoreState.
+func (mr *MockBeaconStateStoreMockRecorder) StoreState(slot, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreState", reflect.TypeOf((*MockBeaconStateStore)(nil).StoreState), slot, state)
 }
 
 // MockBeaconClient is a mock of BeaconClient interface.
@@ -94,17 +161,17 @@ func (mr *MockBeaconClientMockRecorder) BeaconBlockHeader(ctx, opts any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconBlockHeader", reflect.TypeOf((*MockBeaconClient)(nil).BeaconBlockHeader), ctx, opts)
 }
 
-// SignedBeaconBlock mocks base method.
-func (m *MockBeaconClient) SignedBeaconBlock(ctx context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+// SignedBeaconBlockSSZ mocks base method.
+func (m *MockBeaconClient) SignedBeaconBlockSSZ(ctx context.Context, blockID string) (*beacon.SSZResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignedBeaconBlock", ctx, opts)
-	ret0, _ := ret[0].(*api.Response[*spec.VersionedSignedBeaconBlock])
+	ret := m.ctrl.Call(m, "SignedBeaconBlockSSZ", ctx, blockID)
+	ret0, _ := ret[0].(*beacon.SSZResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignedBeaconBlock indicates an expected call of SignedBeaconBlock.
-func (mr *MockBeaconClientMockRecorder) SignedBeaconBlock(ctx, opts any) *gomock.Call {
+// SignedBeaconBlockSSZ indicates an expected call of SignedBeaconBlockSSZ.
+func (mr *MockBeaconClientMockRecorder) SignedBeaconBlockSSZ(ctx, blockID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignedBeaconBlock", reflect.TypeOf((*MockBeaconClient)(nil).SignedBeaconBlock), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignedBeaconBlockSSZ", reflect.TypeOf((*MockBeaconClient)(nil).SignedBeaconBlockSSZ), ctx, blockID)
 }
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/shadow.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/shadow.go -destination=./mock/shadow.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockShadowClient is a mock of ShadowClient interface.
+type MockShadowClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockShadowClientMockRecorder
+}
+
+// MockShadowClientMockRecorder is the mock recorder for MockShadowClient.
+type MockShadowClientMockRecorder struct {
+	mock *MockShadowClient
+}
+
+// NewMockShadowClient creates a new mock instance.
+func NewMockShadowClient(ctrl *gomock.Controller) *MockShadowClient {
+	mock := &MockShadowClient{ctrl: ctrl}
+	mock.recorder = &MockShadowClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShadowClient) EXPECT() *MockShadowClientMockRecorder {
+	return m.recorder
+}
+
+// CallContract mocks base method.
+func (m *MockShadowClient) CallContract(ctx context.Context, callArgs map[string]any, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockShadowClientMockRecorder) CallContract(ctx, callArgs, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockShadowClient)(nil).CallContract), ctx, callArgs, blockNumber)
+}
+
+// EstimateGas mocks base method.
+func (m *MockShadowClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockShadowClientMockRecorder) EstimateGas(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockShadowClient)(nil).EstimateGas), ctx, msg)
+}
+
+// From mocks base method.
+func (m *MockShadowClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockShadowClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockShadowClient)(nil).From))
+}
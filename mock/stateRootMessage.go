@@ -108,6 +108,41 @@ func (mr *MockBlockStorerMockRecorder) StoreBlock(sourceDomainID, destinationDom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreBlock", reflect.TypeOf((*MockBlockStorer)(nil).StoreBlock), sourceDomainID, destinationDomainID, blockNumber)
 }
 
+// MockStateRootMetrics is a mock of StateRootMetrics interface.
+type MockStateRootMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateRootMetricsMockRecorder
+}
+
+// MockStateRootMetricsMockRecorder is the mock recorder for MockStateRootMetrics.
+type MockStateRootMetricsMockRecorder struct {
+	mock *MockStateRootMetrics
+}
+
+// NewMockStateRootMetrics creates a new mock instance.
+func NewMockStateRootMetrics(ctrl *gomock.Controller) *MockStateRootMetrics {
+	mock := &MockStateRootMetrics{ctrl: ctrl}
+	mock.recorder = &MockStateRootMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateRootMetrics) EXPECT() *MockStateRootMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackStateRootMessage mocks base method.
+func (m *MockStateRootMetrics) TrackStateRootMessage(domainID, sourceDomainID uint8) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackStateRootMessage", domainID, sourceDomainID)
+}
+
+// TrackStateRootMessage indicates an expected call of TrackStateRootMessage.
+func (mr *MockStateRootMetricsMockRecorder) TrackStateRootMessage(domainID, sourceDomainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackStateRootMessage", reflect.TypeOf((*MockStateRootMetrics)(nil).TrackStateRootMessage), domainID, sourceDomainID)
+}
+
 // MockEventHandler is a mock of EventHandler interface.
 type MockEventHandler struct {
 	ctrl     *gomock.Controller
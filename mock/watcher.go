@@ -0,0 +1,290 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/watcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./chains/evm/executor/watcher.go -destination=./mock/watcher.go -package mock
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	store "github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatcherClient is a mock of WatcherClient interface.
+type MockWatcherClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherClientMockRecorder
+}
+
+// MockWatcherClientMockRecorder is the mock recorder for MockWatcherClient.
+type MockWatcherClientMockRecorder struct {
+	mock *MockWatcherClient
+}
+
+// NewMockWatcherClient creates a new mock instance.
+func NewMockWatcherClient(ctrl *gomock.Controller) *MockWatcherClient {
+	mock := &MockWatcherClient{ctrl: ctrl}
+	mock.recorder = &MockWatcherClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherClient) EXPECT() *MockWatcherClientMockRecorder {
+	return m.recorder
+}
+
+// BlockByNumber mocks base method.
+func (m *MockWatcherClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockByNumber", ctx, number)
+	ret0, _ := ret[0].(*types.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockByNumber indicates an expected call of BlockByNumber.
+func (mr *MockWatcherClientMockRecorder) BlockByNumber(ctx, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByNumber", reflect.TypeOf((*MockWatcherClient)(nil).BlockByNumber), ctx, number)
+}
+
+// BlockNumber mocks base method.
+func (m *MockWatcherClient) BlockNumber(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber.
+func (mr *MockWatcherClientMockRecorder) BlockNumber(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockWatcherClient)(nil).BlockNumber), ctx)
+}
+
+// CallContract mocks base method.
+func (m *MockWatcherClient) CallContract(ctx context.Context, callArgs map[string]any, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockWatcherClientMockRecorder) CallContract(ctx, callArgs, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockWatcherClient)(nil).CallContract), ctx, callArgs, blockNumber)
+}
+
+// From mocks base method.
+func (m *MockWatcherClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockWatcherClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockWatcherClient)(nil).From))
+}
+
+// NonceAt mocks base method.
+func (m *MockWatcherClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonceAt indicates an expected call of NonceAt.
+func (mr *MockWatcherClientMockRecorder) NonceAt(ctx, account, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceAt", reflect.TypeOf((*MockWatcherClient)(nil).NonceAt), ctx, account, blockNumber)
+}
+
+// TransactionByHash mocks base method.
+func (m *MockWatcherClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionByHash", ctx, hash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TransactionByHash indicates an expected call of TransactionByHash.
+func (mr *MockWatcherClientMockRecorder) TransactionByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionByHash", reflect.TypeOf((*MockWatcherClient)(nil).TransactionByHash), ctx, hash)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockWatcherClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockWatcherClientMockRecorder) TransactionReceipt(ctx, txHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockWatcherClient)(nil).TransactionReceipt), ctx, txHash)
+}
+
+// MockExecutionStorer is a mock of ExecutionStorer interface.
+type MockExecutionStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionStorerMockRecorder
+}
+
+// MockExecutionStorerMockRecorder is the mock recorder for MockExecutionStorer.
+type MockExecutionStorerMockRecorder struct {
+	mock *MockExecutionStorer
+}
+
+// NewMockExecutionStorer creates a new mock instance.
+func NewMockExecutionStorer(ctrl *gomock.Controller) *MockExecutionStorer {
+	mock := &MockExecutionStorer{ctrl: ctrl}
+	mock.recorder = &MockExecutionStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutionStorer) EXPECT() *MockExecutionStorerMockRecorder {
+	return m.recorder
+}
+
+// Execution mocks base method.
+func (m *MockExecutionStorer) Execution(messageID string) (*store.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execution", messageID)
+	ret0, _ := ret[0].(*store.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockExecutionStorerMockRecorder) Execution(messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockExecutionStorer)(nil).Execution), messageID)
+}
+
+// PendingTransactions mocks base method.
+func (m *MockExecutionStorer) PendingTransactions(domainID uint8) ([]*store.PendingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTransactions", domainID)
+	ret0, _ := ret[0].([]*store.PendingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingTransactions indicates an expected call of PendingTransactions.
+func (mr *MockExecutionStorerMockRecorder) PendingTransactions(domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTransactions", reflect.TypeOf((*MockExecutionStorer)(nil).PendingTransactions), domainID)
+}
+
+// RemovePendingTransaction mocks base method.
+func (m *MockExecutionStorer) RemovePendingTransaction(domainID uint8, txHash common.Hash) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePendingTransaction", domainID, txHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePendingTransaction indicates an expected call of RemovePendingTransaction.
+func (mr *MockExecutionStorerMockRecorder) RemovePendingTransaction(domainID, txHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePendingTransaction", reflect.TypeOf((*MockExecutionStorer)(nil).RemovePendingTransaction), domainID, txHash)
+}
+
+// StoreExecution mocks base method.
+func (m *MockExecutionStorer) StoreExecution(execution *store.Execution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreExecution", execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreExecution indicates an expected call of StoreExecution.
+func (mr *MockExecutionStorerMockRecorder) StoreExecution(execution any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreExecution", reflect.TypeOf((*MockExecutionStorer)(nil).StoreExecution), execution)
+}
+
+// StorePendingTransaction mocks base method.
+func (m *MockExecutionStorer) StorePendingTransaction(domainID uint8, tx *store.PendingTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePendingTransaction", domainID, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePendingTransaction indicates an expected call of StorePendingTransaction.
+func (mr *MockExecutionStorerMockRecorder) StorePendingTransaction(domainID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePendingTransaction", reflect.TypeOf((*MockExecutionStorer)(nil).StorePendingTransaction), domainID, tx)
+}
+
+// MockWatcherMetrics is a mock of WatcherMetrics interface.
+type MockWatcherMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherMetricsMockRecorder
+}
+
+// MockWatcherMetricsMockRecorder is the mock recorder for MockWatcherMetrics.
+type MockWatcherMetricsMockRecorder struct {
+	mock *MockWatcherMetrics
+}
+
+// NewMockWatcherMetrics creates a new mock instance.
+func NewMockWatcherMetrics(ctrl *gomock.Controller) *MockWatcherMetrics {
+	mock := &MockWatcherMetrics{ctrl: ctrl}
+	mock.recorder = &MockWatcherMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherMetrics) EXPECT() *MockWatcherMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackExecutionsFailed mocks base method.
+func (m *MockWatcherMetrics) TrackExecutionsFailed(domainID uint8, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackExecutionsFailed", domainID, count)
+}
+
+// TrackExecutionsFailed indicates an expected call of TrackExecutionsFailed.
+func (mr *MockWatcherMetricsMockRecorder) TrackExecutionsFailed(domainID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackExecutionsFailed", reflect.TypeOf((*MockWatcherMetrics)(nil).TrackExecutionsFailed), domainID, count)
+}
+
+// TrackGasSpent mocks base method.
+func (m *MockWatcherMetrics) TrackGasSpent(domainID uint8, gasUsed uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackGasSpent", domainID, gasUsed)
+}
+
+// TrackGasSpent indicates an expected call of TrackGasSpent.
+func (mr *MockWatcherMetricsMockRecorder) TrackGasSpent(domainID, gasUsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackGasSpent", reflect.TypeOf((*MockWatcherMetrics)(nil).TrackGasSpent), domainID, gasUsed)
+}
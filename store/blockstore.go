@@ -4,7 +4,6 @@
 package store
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -13,6 +12,23 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 )
 
+const (
+	// ROUTE_BLOCK_KEY is the key format of the latest block per route stored by the BlockStore
+	ROUTE_BLOCK_KEY = "source:%d:destination:%d:blockNumber"
+	// LISTENER_BLOCK_KEY is the key format of the latest block per domain stored by the sygma-core block store
+	LISTENER_BLOCK_KEY = "chain:%d:block"
+)
+
+// RouteBlockKey returns the key of the latest block of the route
+func RouteBlockKey(sourceDomainID uint8, destinationDomainID uint8) []byte {
+	return []byte(fmt.Sprintf(ROUTE_BLOCK_KEY, sourceDomainID, destinationDomainID))
+}
+
+// ListenerBlockKey returns the key of the latest block of the domain listener
+func ListenerBlockKey(domainID uint8) []byte {
+	return []byte(fmt.Sprintf(LISTENER_BLOCK_KEY, domainID))
+}
+
 type BlockStore struct {
 	db store.KeyValueReaderWriter
 }
@@ -25,10 +41,7 @@ func NewBlockStore(db store.KeyValueReaderWriter) *BlockStore {
 
 // StoreBlock stores latest block number per route
 func (ns *BlockStore) StoreBlock(sourceDomainID uint8, destinationDomainID uint8, blockNumber *big.Int) error {
-	key := bytes.Buffer{}
-	keyS := fmt.Sprintf("source:%d:destination:%d:blockNumber", sourceDomainID, destinationDomainID)
-	key.WriteString(keyS)
-	err := ns.db.SetByKey(key.Bytes(), blockNumber.Bytes())
+	err := ns.db.SetByKey(RouteBlockKey(sourceDomainID, destinationDomainID), blockNumber.Bytes())
 	if err != nil {
 		return err
 	}
@@ -38,11 +51,7 @@ func (ns *BlockStore) StoreBlock(sourceDomainID uint8, destinationDomainID uint8
 
 // LatestBlock returns the latest block indexer per router
 func (ns *BlockStore) LatestBlock(sourceDomainID uint8, destinationDomainID uint8) (*big.Int, error) {
-	key := bytes.Buffer{}
-	keyS := fmt.Sprintf("source:%d:destination:%d:blockNumber", sourceDomainID, destinationDomainID)
-	key.WriteString(keyS)
-
-	v, err := ns.db.GetByKey(key.Bytes())
+	v, err := ns.db.GetByKey(RouteBlockKey(sourceDomainID, destinationDomainID))
 	if err != nil {
 		if errors.Is(err, leveldb.ErrNotFound) {
 			return big.NewInt(0), nil
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	coreStore "github.com/sygmaprotocol/sygma-core/store"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
 	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -77,3 +78,11 @@ func (s *BlockStoreTestSuite) Test_LatestBlock_Successful() {
 	s.Equal(block, big.NewInt(5))
 	s.Nil(err)
 }
+
+func (s *BlockStoreTestSuite) Test_ListenerBlockKey_MatchesCoreBlockStore() {
+	s.keyValueReaderWriter.EXPECT().SetByKey(store.ListenerBlockKey(1), []byte{5}).Return(nil)
+
+	err := coreStore.NewBlockStore(s.keyValueReaderWriter).StoreBlock(big.NewInt(5), 1)
+
+	s.Nil(err)
+}
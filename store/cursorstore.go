@@ -0,0 +1,123 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type CursorType string
+
+const (
+	// RouteCursor is the latest block per route stored by the BlockStore
+	RouteCursor CursorType = "route"
+	// ListenerCursor is the latest block per domain stored by the sygma-core listener
+	ListenerCursor CursorType = "listener"
+)
+
+// Cursor is a block number the relayer continues processing from.
+// Destination is set only for route cursors.
+type Cursor struct {
+	Type        CursorType `json:"type"`
+	Source      uint8      `json:"source"`
+	Destination uint8      `json:"destination,omitempty"`
+	Block       *big.Int   `json:"block"`
+}
+
+// CursorStore reads and writes route and listener cursors directly from the LevelDB
+// database so they can be inspected and edited while the relayer is stopped
+type CursorStore struct {
+	db *leveldb.DB
+}
+
+func NewCursorStore(db *leveldb.DB) *CursorStore {
+	return &CursorStore{
+		db: db,
+	}
+}
+
+// Cursors returns all stored route and listener cursors
+func (s *CursorStore) Cursors() ([]*Cursor, error) {
+	iter := s.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	cursors := make([]*Cursor, 0)
+	for iter.Next() {
+		cursor, ok := parseCursorKey(string(iter.Key()))
+		if !ok {
+			continue
+		}
+
+		cursor.Block = new(big.Int).SetBytes(iter.Value())
+		cursors = append(cursors, cursor)
+	}
+	return cursors, iter.Error()
+}
+
+// Cursor returns the stored block of the cursor or zero if the cursor is not stored
+func (s *CursorStore) Cursor(cursorType CursorType, source uint8, destination uint8) (*Cursor, error) {
+	cursor := &Cursor{
+		Type:        cursorType,
+		Source:      source,
+		Destination: destination,
+	}
+	key, err := cursorKey(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.db.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			cursor.Block = big.NewInt(0)
+			return cursor, nil
+		}
+		return nil, err
+	}
+
+	cursor.Block = new(big.Int).SetBytes(value)
+	return cursor, nil
+}
+
+// StoreCursor replaces the stored block of the cursor
+func (s *CursorStore) StoreCursor(cursor *Cursor) error {
+	if cursor.Block == nil || cursor.Block.Sign() < 0 {
+		return fmt.Errorf("invalid block %s for %s cursor %d", cursor.Block, cursor.Type, cursor.Source)
+	}
+	key, err := cursorKey(cursor)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(key, cursor.Block.Bytes(), nil)
+}
+
+func cursorKey(cursor *Cursor) ([]byte, error) {
+	switch cursor.Type {
+	case RouteCursor:
+		return RouteBlockKey(cursor.Source, cursor.Destination), nil
+	case ListenerCursor:
+		return ListenerBlockKey(cursor.Source), nil
+	default:
+		return nil, fmt.Errorf("invalid cursor type %s", cursor.Type)
+	}
+}
+
+func parseCursorKey(key string) (*Cursor, bool) {
+	var source, destination uint8
+	_, err := fmt.Sscanf(key, ROUTE_BLOCK_KEY, &source, &destination)
+	if err == nil && key == string(RouteBlockKey(source, destination)) {
+		return &Cursor{Type: RouteCursor, Source: source, Destination: destination}, true
+	}
+
+	_, err = fmt.Sscanf(key, LISTENER_BLOCK_KEY, &source)
+	if err == nil && key == string(ListenerBlockKey(source)) {
+		return &Cursor{Type: ListenerCursor, Source: source}, true
+	}
+	return nil, false
+}
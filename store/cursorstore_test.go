@@ -0,0 +1,84 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+type CursorStoreTestSuite struct {
+	suite.Suite
+
+	db          *leveldb.DB
+	cursorStore *store.CursorStore
+}
+
+func TestRunCursorStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(CursorStoreTestSuite))
+}
+
+func (s *CursorStoreTestSuite) SetupTest() {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	s.Nil(err)
+	s.db = db
+	s.cursorStore = store.NewCursorStore(db)
+}
+
+func (s *CursorStoreTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *CursorStoreTestSuite) Test_Cursors_SkipsOtherKeys() {
+	_ = s.db.Put([]byte("source:1:destination:2:blockNumber"), []byte{100}, nil)
+	_ = s.db.Put([]byte("chain:1:block"), []byte{50}, nil)
+	_ = s.db.Put([]byte("domain:1:retryQueue"), []byte("[]"), nil)
+	_ = s.db.Put([]byte("message:1-2:execution"), []byte("{}"), nil)
+
+	cursors, err := s.cursorStore.Cursors()
+
+	s.Nil(err)
+	s.Equal(cursors, []*store.Cursor{
+		{Type: store.ListenerCursor, Source: 1, Block: big.NewInt(50)},
+		{Type: store.RouteCursor, Source: 1, Destination: 2, Block: big.NewInt(100)},
+	})
+}
+
+func (s *CursorStoreTestSuite) Test_Cursor_NotFound() {
+	cursor, err := s.cursorStore.Cursor(store.RouteCursor, 1, 2)
+
+	s.Nil(err)
+	s.Equal(cursor.Block, big.NewInt(0))
+}
+
+func (s *CursorStoreTestSuite) Test_StoreCursor_InvalidType() {
+	err := s.cursorStore.StoreCursor(&store.Cursor{Type: "invalid", Source: 1, Block: big.NewInt(1)})
+
+	s.NotNil(err)
+}
+
+func (s *CursorStoreTestSuite) Test_StoreCursor_NegativeBlock() {
+	err := s.cursorStore.StoreCursor(&store.Cursor{Type: store.ListenerCursor, Source: 1, Block: big.NewInt(-1)})
+
+	s.NotNil(err)
+}
+
+func (s *CursorStoreTestSuite) Test_StoreCursor_CompatibleWithBlockStores() {
+	err := s.cursorStore.StoreCursor(&store.Cursor{Type: store.RouteCursor, Source: 1, Destination: 2, Block: big.NewInt(300)})
+	s.Nil(err)
+	err = s.cursorStore.StoreCursor(&store.Cursor{Type: store.ListenerCursor, Source: 1, Block: big.NewInt(400)})
+	s.Nil(err)
+
+	routeBlock, err := s.db.Get([]byte("source:1:destination:2:blockNumber"), nil)
+	s.Nil(err)
+	s.Equal(new(big.Int).SetBytes(routeBlock), big.NewInt(300))
+	listenerBlock, err := s.db.Get([]byte("chain:1:block"), nil)
+	s.Nil(err)
+	s.Equal(new(big.Int).SetBytes(listenerBlock), big.NewInt(400))
+}
@@ -0,0 +1,158 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sygmaprotocol/sygma-core/store"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type ExecutionStatus string
+
+const (
+	PendingExecution    ExecutionStatus = "pending"
+	SuccessfulExecution ExecutionStatus = "successful"
+	FailedExecution     ExecutionStatus = "failed"
+	DroppedExecution    ExecutionStatus = "dropped"
+)
+
+// Execution is the status of the latest transaction that executed a message
+type Execution struct {
+	MessageID    string          `json:"messageId"`
+	DomainID     uint8           `json:"domainId"`
+	TxHash       common.Hash     `json:"txHash"`
+	Status       ExecutionStatus `json:"status"`
+	BlockNumber  uint64          `json:"blockNumber,omitempty"`
+	GasUsed      uint64          `json:"gasUsed,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Attempts     uint64          `json:"attempts"`
+}
+
+// PendingTransaction is an execution transaction waiting for its receipt. Proposals are
+// stored so that the transaction can be watched again after the relayer restarts.
+type PendingTransaction struct {
+	TxHash    common.Hash     `json:"txHash"`
+	Nonce     *uint64         `json:"nonce,omitempty"`
+	SentBlock uint64          `json:"sentBlock"`
+	SentAt    time.Time       `json:"sentAt"`
+	Attempts  uint64          `json:"attempts"`
+	Proposals json.RawMessage `json:"proposals"`
+}
+
+type ExecutionStore struct {
+	db   store.KeyValueReaderWriter
+	lock sync.Mutex
+}
+
+func NewExecutionStore(db store.KeyValueReaderWriter) *ExecutionStore {
+	return &ExecutionStore{
+		db: db,
+	}
+}
+
+// StoreExecution stores the execution status of the message
+func (s *ExecutionStore) StoreExecution(execution *Execution) error {
+	value, err := json.Marshal(execution)
+	if err != nil {
+		return err
+	}
+
+	return s.db.SetByKey(executionKey(execution.MessageID), value)
+}
+
+// Execution returns the execution status of the message or nil if the message was never executed
+func (s *ExecutionStore) Execution(messageID string) (*Execution, error) {
+	value, err := s.db.GetByKey(executionKey(messageID))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	execution := &Execution{}
+	err = json.Unmarshal(value, execution)
+	if err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// StorePendingTransaction adds the transaction to the pending transactions of the domain
+func (s *ExecutionStore) StorePendingTransaction(domainID uint8, tx *PendingTransaction) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	txs, err := s.pendingTransactions(domainID)
+	if err != nil {
+		return err
+	}
+	return s.storePendingTransactions(domainID, append(txs, tx))
+}
+
+// RemovePendingTransaction removes the transaction from the pending transactions of the domain
+func (s *ExecutionStore) RemovePendingTransaction(domainID uint8, txHash common.Hash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	txs, err := s.pendingTransactions(domainID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]*PendingTransaction, 0, len(txs))
+	for _, tx := range txs {
+		if tx.TxHash != txHash {
+			remaining = append(remaining, tx)
+		}
+	}
+	return s.storePendingTransactions(domainID, remaining)
+}
+
+// PendingTransactions returns execution transactions of the domain that are waiting for receipts
+func (s *ExecutionStore) PendingTransactions(domainID uint8) ([]*PendingTransaction, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pendingTransactions(domainID)
+}
+
+func (s *ExecutionStore) pendingTransactions(domainID uint8) ([]*PendingTransaction, error) {
+	value, err := s.db.GetByKey(pendingTransactionsKey(domainID))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return []*PendingTransaction{}, nil
+		}
+		return nil, err
+	}
+
+	txs := []*PendingTransaction{}
+	err = json.Unmarshal(value, &txs)
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func (s *ExecutionStore) storePendingTransactions(domainID uint8, txs []*PendingTransaction) error {
+	value, err := json.Marshal(txs)
+	if err != nil {
+		return err
+	}
+	return s.db.SetByKey(pendingTransactionsKey(domainID), value)
+}
+
+func pendingTransactionsKey(domainID uint8) []byte {
+	return []byte(fmt.Sprintf("domain:%d:pendingTransactions", domainID))
+}
+
+func executionKey(messageID string) []byte {
+	return []byte(fmt.Sprintf("message:%s:execution", messageID))
+}
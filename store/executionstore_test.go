@@ -0,0 +1,126 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"github.com/syndtr/goleveldb/leveldb"
+	"go.uber.org/mock/gomock"
+)
+
+type ExecutionStoreTestSuite struct {
+	suite.Suite
+	executionStore       *store.ExecutionStore
+	keyValueReaderWriter *mock.MockKeyValueReaderWriter
+	execution            *store.Execution
+}
+
+func TestRunExecutionStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutionStoreTestSuite))
+}
+
+func (s *ExecutionStoreTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.keyValueReaderWriter = mock.NewMockKeyValueReaderWriter(gomockController)
+	s.executionStore = store.NewExecutionStore(s.keyValueReaderWriter)
+	s.execution = &store.Execution{
+		MessageID:   "1-2-3-4",
+		DomainID:    3,
+		TxHash:      common.HexToHash("0x1"),
+		Status:      store.SuccessfulExecution,
+		BlockNumber: 100,
+		GasUsed:     50000,
+		Attempts:    1,
+	}
+}
+
+func (s *ExecutionStoreTestSuite) Test_StoreExecution_FailedStore() {
+	key := "message:1-2-3-4:execution"
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), gomock.Any()).Return(errors.New("error"))
+
+	err := s.executionStore.StoreExecution(s.execution)
+
+	s.NotNil(err)
+}
+
+func (s *ExecutionStoreTestSuite) Test_StoreExecution_SuccessfulStore() {
+	key := "message:1-2-3-4:execution"
+	value, _ := json.Marshal(s.execution)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), value).Return(nil)
+
+	err := s.executionStore.StoreExecution(s.execution)
+
+	s.Nil(err)
+}
+
+func (s *ExecutionStoreTestSuite) Test_Execution_FailedFetch() {
+	key := "message:1-2-3-4:execution"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, errors.New("error"))
+
+	_, err := s.executionStore.Execution("1-2-3-4")
+
+	s.NotNil(err)
+}
+
+func (s *ExecutionStoreTestSuite) Test_Execution_NotFound() {
+	key := "message:1-2-3-4:execution"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	execution, err := s.executionStore.Execution("1-2-3-4")
+
+	s.Nil(execution)
+	s.Nil(err)
+}
+
+func (s *ExecutionStoreTestSuite) Test_Execution_Successful() {
+	key := "message:1-2-3-4:execution"
+	value, _ := json.Marshal(s.execution)
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(value, nil)
+
+	execution, err := s.executionStore.Execution("1-2-3-4")
+
+	s.Nil(err)
+	s.Equal(execution, s.execution)
+}
+
+func (s *ExecutionStoreTestSuite) Test_PendingTransactions_NotFound() {
+	key := "domain:2:pendingTransactions"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	txs, err := s.executionStore.PendingTransactions(2)
+
+	s.Nil(err)
+	s.Equal(txs, []*store.PendingTransaction{})
+}
+
+func (s *ExecutionStoreTestSuite) Test_StorePendingTransaction_Appended() {
+	key := "domain:2:pendingTransactions"
+	existing, _ := json.Marshal([]*store.PendingTransaction{{TxHash: common.HexToHash("0x1")}})
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(existing, nil)
+	expected, _ := json.Marshal([]*store.PendingTransaction{{TxHash: common.HexToHash("0x1")}, {TxHash: common.HexToHash("0x2")}})
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), expected).Return(nil)
+
+	err := s.executionStore.StorePendingTransaction(2, &store.PendingTransaction{TxHash: common.HexToHash("0x2")})
+
+	s.Nil(err)
+}
+
+func (s *ExecutionStoreTestSuite) Test_RemovePendingTransaction_Removed() {
+	key := "domain:2:pendingTransactions"
+	existing, _ := json.Marshal([]*store.PendingTransaction{{TxHash: common.HexToHash("0x1")}, {TxHash: common.HexToHash("0x2")}})
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(existing, nil)
+	expected, _ := json.Marshal([]*store.PendingTransaction{{TxHash: common.HexToHash("0x2")}})
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), expected).Return(nil)
+
+	err := s.executionStore.RemovePendingTransaction(2, common.HexToHash("0x1"))
+
+	s.Nil(err)
+}
@@ -0,0 +1,79 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sygmaprotocol/sygma-core/store"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// RetryEntry is a set of encoded proposals waiting for another execution attempt
+type RetryEntry struct {
+	Proposals   json.RawMessage `json:"proposals"`
+	Attempts    uint64          `json:"attempts"`
+	NextAttempt time.Time       `json:"nextAttempt"`
+	Error       string          `json:"error"`
+}
+
+// RetryStore persists the retry queue and the dead-letter list of each domain
+type RetryStore struct {
+	db store.KeyValueReaderWriter
+}
+
+func NewRetryStore(db store.KeyValueReaderWriter) *RetryStore {
+	return &RetryStore{
+		db: db,
+	}
+}
+
+// StoreRetryQueue replaces the retry queue of the domain
+func (s *RetryStore) StoreRetryQueue(domainID uint8, entries []*RetryEntry) error {
+	return s.storeEntries(fmt.Sprintf("domain:%d:retryQueue", domainID), entries)
+}
+
+// RetryQueue returns the retry queue of the domain
+func (s *RetryStore) RetryQueue(domainID uint8) ([]*RetryEntry, error) {
+	return s.entries(fmt.Sprintf("domain:%d:retryQueue", domainID))
+}
+
+// StoreDeadLetters replaces the list of entries that reached the maximum execution attempts
+func (s *RetryStore) StoreDeadLetters(domainID uint8, entries []*RetryEntry) error {
+	return s.storeEntries(fmt.Sprintf("domain:%d:deadLetters", domainID), entries)
+}
+
+// DeadLetters returns the list of entries that reached the maximum execution attempts
+func (s *RetryStore) DeadLetters(domainID uint8) ([]*RetryEntry, error) {
+	return s.entries(fmt.Sprintf("domain:%d:deadLetters", domainID))
+}
+
+func (s *RetryStore) storeEntries(key string, entries []*RetryEntry) error {
+	value, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	return s.db.SetByKey([]byte(key), value)
+}
+
+func (s *RetryStore) entries(key string) ([]*RetryEntry, error) {
+	value, err := s.db.GetByKey([]byte(key))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return []*RetryEntry{}, nil
+		}
+		return nil, err
+	}
+
+	entries := []*RetryEntry{}
+	err = json.Unmarshal(value, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
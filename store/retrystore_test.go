@@ -0,0 +1,91 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/mock"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+	"github.com/syndtr/goleveldb/leveldb"
+	"go.uber.org/mock/gomock"
+)
+
+type RetryStoreTestSuite struct {
+	suite.Suite
+	retryStore           *store.RetryStore
+	keyValueReaderWriter *mock.MockKeyValueReaderWriter
+	entries              []*store.RetryEntry
+}
+
+func TestRunRetryStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryStoreTestSuite))
+}
+
+func (s *RetryStoreTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.keyValueReaderWriter = mock.NewMockKeyValueReaderWriter(gomockController)
+	s.retryStore = store.NewRetryStore(s.keyValueReaderWriter)
+	s.entries = []*store.RetryEntry{
+		{
+			Proposals:   json.RawMessage(`[]`),
+			Attempts:    1,
+			NextAttempt: time.Unix(1000, 0).UTC(),
+			Error:       "error",
+		},
+	}
+}
+
+func (s *RetryStoreTestSuite) Test_StoreRetryQueue_FailedStore() {
+	key := "domain:1:retryQueue"
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), gomock.Any()).Return(errors.New("error"))
+
+	err := s.retryStore.StoreRetryQueue(1, s.entries)
+
+	s.NotNil(err)
+}
+
+func (s *RetryStoreTestSuite) Test_RetryQueue_NotFound() {
+	key := "domain:1:retryQueue"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	entries, err := s.retryStore.RetryQueue(1)
+
+	s.Nil(err)
+	s.Equal(entries, []*store.RetryEntry{})
+}
+
+func (s *RetryStoreTestSuite) Test_RetryQueue_Successful() {
+	key := "domain:1:retryQueue"
+	value, _ := json.Marshal(s.entries)
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(value, nil)
+
+	entries, err := s.retryStore.RetryQueue(1)
+
+	s.Nil(err)
+	s.Equal(entries, s.entries)
+}
+
+func (s *RetryStoreTestSuite) Test_StoreDeadLetters_SuccessfulStore() {
+	key := "domain:1:deadLetters"
+	value, _ := json.Marshal(s.entries)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), value).Return(nil)
+
+	err := s.retryStore.StoreDeadLetters(1, s.entries)
+
+	s.Nil(err)
+}
+
+func (s *RetryStoreTestSuite) Test_DeadLetters_FailedFetch() {
+	key := "domain:1:deadLetters"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, errors.New("error"))
+
+	_, err := s.retryStore.DeadLetters(1)
+
+	s.NotNil(err)
+}
@@ -0,0 +1,186 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+)
+
+const stateFileExtension = ".ssz"
+
+// stateForks maps the fork byte stored before the SSZ encoded state to the fork of the state.
+// The bytes are persisted in state files and must never change.
+var stateForks = map[byte]spec.DataVersion{
+	1: spec.DataVersionCapella,
+	2: spec.DataVersionDeneb,
+	3: spec.DataVersionElectra,
+}
+
+func forkByte(version spec.DataVersion) (byte, error) {
+	for b, fork := range stateForks {
+		if fork == version {
+			return b, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported beacon fork %s", version)
+}
+
+// BeaconStateStore persists SSZ encoded beacon states in a directory, one file per slot.
+// Only the most recently used states up to the store size are kept.
+type BeaconStateStore struct {
+	path string
+	size int
+	lock sync.Mutex
+}
+
+// NewBeaconStateStore creates the state store in the network subdirectory of the given path,
+// since states are keyed by slot only and stores of different networks can share the path
+func NewBeaconStateStore(path string, network string, size int) (*BeaconStateStore, error) {
+	path = filepath.Join(path, network)
+	err := os.MkdirAll(path, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BeaconStateStore{
+		path: path,
+		size: size,
+	}, nil
+}
+
+// StoreState stores the SSZ encoded beacon state of the given slot and removes the least
+// recently used states if the store is full
+func (s *BeaconStateStore) StoreState(slot *big.Int, state *beacon.SSZResponse) error {
+	fork, err := forkByte(state.Version)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err = s.writeFile(s.statePath(slot), append([]byte{fork}, state.Data...))
+	if err != nil {
+		return err
+	}
+
+	return s.prune()
+}
+
+// writeFile writes the data to a temporary file in the store directory and renames it
+// to the given path, so that a partially written state is never read
+func (s *BeaconStateStore) writeFile(path string, data []byte) error {
+	file, err := os.CreateTemp(s.path, "state-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// State returns the stored SSZ encoded beacon state of the given slot or nil if the state is not stored
+func (s *BeaconStateStore) State(slot *big.Int) (*beacon.SSZResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	data, err := os.ReadFile(s.statePath(slot))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty beacon state file for slot %s", slot)
+	}
+
+	now := time.Now()
+	err = os.Chtimes(s.statePath(slot), now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := stateForks[data[0]]
+	if !ok {
+		return nil, fmt.Errorf("unsupported beacon fork byte %d of slot %s state", data[0], slot)
+	}
+
+	return &beacon.SSZResponse{
+		Version: version,
+		Data:    data[1:],
+	}, nil
+}
+
+// DeleteState removes the stored beacon state of the given slot if it exists
+func (s *BeaconStateStore) DeleteState(slot *big.Int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := os.Remove(s.statePath(slot))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// prune removes the least recently used states exceeding the store size
+func (s *BeaconStateStore) prune() error {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return err
+	}
+
+	files := make([]os.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), stateFileExtension) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, info)
+	}
+	if len(files) <= s.size {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	for _, file := range files[:len(files)-s.size] {
+		err = os.Remove(filepath.Join(s.path, file.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BeaconStateStore) statePath(slot *big.Int) string {
+	return filepath.Join(s.path, slot.String()+stateFileExtension)
+}
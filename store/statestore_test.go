@@ -0,0 +1,137 @@
+// The Licensed Work is (c) 2023 Sygma
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/suite"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/chains/evm/beacon"
+	"github.com/sygmaprotocol/sygma-inclusion-prover/store"
+)
+
+type BeaconStateStoreTestSuite struct {
+	suite.Suite
+	stateStore *store.BeaconStateStore
+	path       string
+}
+
+func TestRunBeaconStateStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BeaconStateStoreTestSuite))
+}
+
+func (s *BeaconStateStoreTestSuite) SetupTest() {
+	path := s.T().TempDir()
+	stateStore, err := store.NewBeaconStateStore(path, "mainnet", 1)
+	if err != nil {
+		panic(err)
+	}
+	s.path = filepath.Join(path, "mainnet")
+	s.stateStore = stateStore
+}
+
+func (s *BeaconStateStoreTestSuite) Test_State_MissingState() {
+	state, err := s.stateStore.State(big.NewInt(5))
+
+	s.Nil(err)
+	s.Nil(state)
+}
+
+func (s *BeaconStateStoreTestSuite) Test_StoreState_SuccessfulStore() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionDeneb, 5))
+	s.Nil(err)
+
+	state, err := s.stateStore.State(big.NewInt(5))
+
+	s.Nil(err)
+	s.Equal(state, testBeaconState(spec.DataVersionDeneb, 5))
+}
+
+func (s *BeaconStateStoreTestSuite) Test_StoreState_PrunesLeastRecentlyUsed() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionDeneb, 5))
+	s.Nil(err)
+	err = s.stateStore.StoreState(big.NewInt(6), testBeaconState(spec.DataVersionDeneb, 6))
+	s.Nil(err)
+
+	state, err := s.stateStore.State(big.NewInt(5))
+	s.Nil(err)
+	s.Nil(state)
+	state, err = s.stateStore.State(big.NewInt(6))
+	s.Nil(err)
+	s.Equal(state, testBeaconState(spec.DataVersionDeneb, 6))
+}
+
+func (s *BeaconStateStoreTestSuite) Test_StoreState_Electra() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionElectra, 5))
+	s.Nil(err)
+
+	state, err := s.stateStore.State(big.NewInt(5))
+
+	s.Nil(err)
+	s.Equal(state, testBeaconState(spec.DataVersionElectra, 5))
+}
+
+func (s *BeaconStateStoreTestSuite) Test_StoreState_StableForkPrefix() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionDeneb, 5))
+	s.Nil(err)
+
+	data, err := os.ReadFile(filepath.Join(s.path, "5.ssz"))
+	s.Nil(err)
+	s.Equal(data, []byte{2, 5, 1, 2, 3})
+	entries, err := os.ReadDir(s.path)
+	s.Nil(err)
+	s.Equal(len(entries), 1)
+}
+
+func (s *BeaconStateStoreTestSuite) Test_StoreState_UnsupportedFork() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionBellatrix, 5))
+
+	s.NotNil(err)
+}
+
+func (s *BeaconStateStoreTestSuite) Test_State_UnsupportedForkByte() {
+	err := os.WriteFile(filepath.Join(s.path, "5.ssz"), []byte{9, 1, 2, 3}, 0600)
+	s.Nil(err)
+
+	_, err = s.stateStore.State(big.NewInt(5))
+
+	s.NotNil(err)
+}
+
+func (s *BeaconStateStoreTestSuite) Test_DeleteState_RemovesState() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionDeneb, 5))
+	s.Nil(err)
+
+	err = s.stateStore.DeleteState(big.NewInt(5))
+	s.Nil(err)
+
+	state, err := s.stateStore.State(big.NewInt(5))
+	s.Nil(err)
+	s.Nil(state)
+	err = s.stateStore.DeleteState(big.NewInt(5))
+	s.Nil(err)
+}
+
+func (s *BeaconStateStoreTestSuite) Test_State_SeparatesNetworks() {
+	err := s.stateStore.StoreState(big.NewInt(5), testBeaconState(spec.DataVersionDeneb, 5))
+	s.Nil(err)
+	gnosisStateStore, err := store.NewBeaconStateStore(filepath.Dir(s.path), "gnosis", 1)
+	s.Nil(err)
+
+	state, err := gnosisStateStore.State(big.NewInt(5))
+
+	s.Nil(err)
+	s.Nil(state)
+}
+
+func testBeaconState(version spec.DataVersion, slot byte) *beacon.SSZResponse {
+	return &beacon.SSZResponse{
+		Version: version,
+		Data:    []byte{slot, 1, 2, 3},
+	}
+}